@@ -54,6 +54,9 @@ const (
 	BuildSkippedReason              = "BuildSkipped"
 	BuildSuccessfulReason           = "BuildSuccessful"
 	BuildMarkedToRestartReason      = "BuildMarkedToRestart"
+	JobCompletedReason              = "JobCompleted"
+	JobFailedReason                 = "JobFailed"
+	ScaledDownWhileIdleReason       = "ScaledDownWhileIdle"
 )
 
 // Condition describes the common structure for conditions in our types
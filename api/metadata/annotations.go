@@ -32,6 +32,14 @@ const (
 	OperatorIDAnnotation        = Domain + "/operator.id"
 	RestartedAt                 = Domain + "/restartedAt"
 	Checksum                    = Domain + "/checksum-config"
+	// WakeUp scales up a workflow running in dev mode that was scaled down while idle, the operator removes it afterward
+	WakeUp = Domain + "/wakeUp"
+	// BuildPriority priority of the workflow build in the platform build queue, builds with higher priority are scheduled first
+	BuildPriority = Domain + "/buildPriority"
+	// BuildCompletedAt completion time of the build whose image is rolled out, forces a new blue/green color when the same image tag is rebuilt
+	BuildCompletedAt = Domain + "/buildCompletedAt"
+	// ExposureAnnotations comma separated keys of the Ingress annotations set from the exposure, removed once dropped from it
+	ExposureAnnotations = Domain + "/exposureAnnotations"
 )
 
 const (
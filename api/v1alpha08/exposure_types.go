@@ -0,0 +1,79 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1alpha08
+
+// ExposureSpec describes how a workflow or a platform service is exposed outside the cluster with a networking.k8s.io/v1 Ingress.
+type ExposureSpec struct {
+	// Host is the fully qualified domain name the Ingress serves.
+	// +kubebuilder:validation:Required
+	Host string `json:"host"`
+	// Path the service is exposed at. Defaults to "/".
+	// +optional
+	Path string `json:"path,omitempty"`
+	// IngressClassName is the IngressClass handling the Ingress. When empty, the cluster default class is used.
+	// +optional
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+	// TLSSecretName is the name of the Secret holding the TLS certificate for the host. When set, the endpoint is exposed with https.
+	// +optional
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+	// Annotations added to the Ingress, for example, to configure the ingress controller.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// GetPath returns the configured path or the default one.
+func (e *ExposureSpec) GetPath() string {
+	if len(e.Path) == 0 {
+		return "/"
+	}
+	return e.Path
+}
+
+// HTTPRouteSpec describes the Gateway API HTTPRoute attaching a workflow or a platform service to a Gateway.
+type HTTPRouteSpec struct {
+	// GatewayRef is the Gateway the HTTPRoute is attached to.
+	// +kubebuilder:validation:Required
+	GatewayRef GatewayReference `json:"gatewayRef"`
+	// Hostnames matched by the HTTPRoute. When empty, the Gateway listener hostname applies.
+	// +optional
+	Hostnames []string `json:"hostnames,omitempty"`
+	// Path prefix matched by the HTTPRoute. Defaults to "/".
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// GetPath returns the configured path or the default one.
+func (h *HTTPRouteSpec) GetPath() string {
+	if len(h.Path) == 0 {
+		return "/"
+	}
+	return h.Path
+}
+
+// GatewayReference identifies a Gateway API Gateway.
+type GatewayReference struct {
+	// Name of the Gateway.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Namespace of the Gateway. Defaults to the namespace of the HTTPRoute.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// SectionName is the name of the Gateway listener to attach to. When empty, the HTTPRoute attaches to all the listeners.
+	// +optional
+	SectionName string `json:"sectionName,omitempty"`
+}
@@ -0,0 +1,120 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1alpha08
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// DefaultTopologySpreadMaxSkew is the maximum difference of workflow pods among the topology domains.
+	DefaultTopologySpreadMaxSkew int32 = 1
+)
+
+// DefaultDisruptionBudgetMaxUnavailable is the number of workflow pods that can be evicted at once when no policy is configured.
+var DefaultDisruptionBudgetMaxUnavailable = intstr.FromInt32(1)
+
+// DefaultTopologySpreadKeys are the node labels used to spread the workflow pods when no key is configured.
+var DefaultTopologySpreadKeys = []string{corev1.LabelTopologyZone, corev1.LabelHostname}
+
+// PodDisruptionBudgetSpec configures the PodDisruptionBudget created for workflows running more than one replica.
+// Only used by the "kubernetes" deployment model.
+type PodDisruptionBudgetSpec struct {
+	// Disabled skips the creation of the PodDisruptionBudget for this workflow.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="disabled"
+	Disabled bool `json:"disabled,omitempty"`
+	// MinAvailable is the number or percent of workflow pods that must stay available during a disruption.
+	// Takes precedence over MaxUnavailable.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="minAvailable"
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// MaxUnavailable is the number or percent of workflow pods that can be unavailable during a disruption.
+	// Defaults to 1 if MinAvailable is not set.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="maxUnavailable"
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// TopologySpreadSpec configures the default topology spread constraints applied to workflows running more than one replica.
+// Ignored if the workflow pod template already defines its own topologySpreadConstraints.
+type TopologySpreadSpec struct {
+	// Disabled skips the default topology spread constraints for this workflow.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="disabled"
+	Disabled bool `json:"disabled,omitempty"`
+	// TopologyKeys are the node labels used to spread the workflow pods. Defaults to the zone and the hostname.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="topologyKeys"
+	TopologyKeys []string `json:"topologyKeys,omitempty"`
+	// MaxSkew is the maximum difference of workflow pods among the topology domains. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="maxSkew"
+	MaxSkew *int32 `json:"maxSkew,omitempty"`
+	// WhenUnsatisfiable tells the scheduler how to deal with pods that don't satisfy the spread constraints. Defaults to "ScheduleAnyway".
+	// +kubebuilder:validation:Enum=DoNotSchedule;ScheduleAnyway
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="whenUnsatisfiable"
+	WhenUnsatisfiable corev1.UnsatisfiableConstraintAction `json:"whenUnsatisfiable,omitempty"`
+}
+
+// GetMinAvailable returns the configured minAvailable, nil if the budget is defined by its maxUnavailable.
+func (p *PodDisruptionBudgetSpec) GetMinAvailable() *intstr.IntOrString {
+	if p == nil {
+		return nil
+	}
+	return p.MinAvailable
+}
+
+// GetMaxUnavailable returns the configured maxUnavailable or the default one. Returns nil if MinAvailable is set.
+func (p *PodDisruptionBudgetSpec) GetMaxUnavailable() *intstr.IntOrString {
+	if p != nil && p.MinAvailable != nil {
+		return nil
+	}
+	if p == nil || p.MaxUnavailable == nil {
+		maxUnavailable := DefaultDisruptionBudgetMaxUnavailable
+		return &maxUnavailable
+	}
+	return p.MaxUnavailable
+}
+
+// GetTopologyKeys returns the configured topology keys or the default ones.
+func (t *TopologySpreadSpec) GetTopologyKeys() []string {
+	if t == nil || len(t.TopologyKeys) == 0 {
+		return DefaultTopologySpreadKeys
+	}
+	return t.TopologyKeys
+}
+
+// GetMaxSkew returns the configured max skew or the default one.
+func (t *TopologySpreadSpec) GetMaxSkew() int32 {
+	if t == nil || t.MaxSkew == nil {
+		return DefaultTopologySpreadMaxSkew
+	}
+	return *t.MaxSkew
+}
+
+// GetWhenUnsatisfiable returns the configured action or "ScheduleAnyway".
+func (t *TopologySpreadSpec) GetWhenUnsatisfiable() corev1.UnsatisfiableConstraintAction {
+	if t == nil || len(t.WhenUnsatisfiable) == 0 {
+		return corev1.ScheduleAnyway
+	}
+	return t.WhenUnsatisfiable
+}
@@ -0,0 +1,71 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1alpha08
+
+// HealthProbesSpec tunes the liveness, readiness and startup probes the operator configures for the workflow container.
+// The tuning is merged with the operator defaults: the workflow tuning takes precedence over the platform one, and
+// the probes defined in the workflow container take precedence over both.
+type HealthProbesSpec struct {
+	// Liveness tunes the probe restarting the workflow container when it stops responding.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="liveness"
+	Liveness *ProbeTuningSpec `json:"liveness,omitempty"`
+	// Readiness tunes the probe removing the workflow container from the service endpoints when it can't serve requests.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="readiness"
+	Readiness *ProbeTuningSpec `json:"readiness,omitempty"`
+	// Startup tunes the probe holding the other probes until the workflow container has started.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="startup"
+	Startup *ProbeTuningSpec `json:"startup,omitempty"`
+	// AdaptiveStartup derives the startup probe budget from the startup time observed for the workflow pods, so that
+	// slow starting workflows, for example, with many OpenAPI resources to load, aren't killed before they're ready.
+	// The budget only grows: it's never lower than the configured one.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="adaptiveStartup"
+	AdaptiveStartup *bool `json:"adaptiveStartup,omitempty"`
+}
+
+// ProbeTuningSpec overrides the timing attributes of a probe. Unset attributes keep the operator defaults.
+type ProbeTuningSpec struct {
+	// InitialDelaySeconds is the number of seconds after the container has started before the probe is initiated.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	InitialDelaySeconds *int32 `json:"initialDelaySeconds,omitempty"`
+	// TimeoutSeconds is the number of seconds after which the probe times out.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+	// PeriodSeconds is how often, in seconds, to perform the probe.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	PeriodSeconds *int32 `json:"periodSeconds,omitempty"`
+	// FailureThreshold is the number of consecutive failures for the probe to be considered failed.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+}
+
+// IsAdaptiveStartup returns true if the startup probe budget must be derived from the observed startup time.
+// The workflow setting takes precedence over the platform one.
+func (h *HealthProbesSpec) IsAdaptiveStartup(platformProbes *HealthProbesSpec) bool {
+	if h != nil && h.AdaptiveStartup != nil {
+		return *h.AdaptiveStartup
+	}
+	return platformProbes != nil && platformProbes.AdaptiveStartup != nil && *platformProbes.AdaptiveStartup
+}
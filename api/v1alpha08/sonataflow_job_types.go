@@ -0,0 +1,96 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1alpha08
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JobDeploymentSpec configures the Kubernetes Job running a workflow instance to completion.
+// Only used by the "job" deployment model.
+type JobDeploymentSpec struct {
+	// Input is the JSON data the workflow instance is started with. If not set, the instance starts with an empty input.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="input"
+	Input *JobInputSource `json:"input,omitempty"`
+	// TTLSecondsAfterFinished removes the Job and its pods once the given seconds elapsed after the instance finished.
+	// The instance outcome is kept in the workflow status and the Job isn't run again unless the workflow changes.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="ttlSecondsAfterFinished"
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+	// BackoffLimit is the number of times a failed instance is run again before the Job is marked as failed. Defaults to 0.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="backoffLimit"
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// ActiveDeadlineSeconds is the maximum duration of the Job, the instance is terminated and marked as failed once reached.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="activeDeadlineSeconds"
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+}
+
+// GetBackoffLimit gets the number of retries of a failed instance.
+func (j *JobDeploymentSpec) GetBackoffLimit() int32 {
+	if j == nil || j.BackoffLimit == nil {
+		return 0
+	}
+	return *j.BackoffLimit
+}
+
+// JobInputSource selects the key holding the workflow instance input. Only one of the sources must be set.
+type JobInputSource struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap in the workflow namespace.
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+	// SecretKeyRef selects a key of a Secret in the workflow namespace.
+	// +optional
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// JobPhase is the phase of the workflow instance run by a Job.
+type JobPhase string
+
+const (
+	JobPhaseRunning   JobPhase = "Running"
+	JobPhaseSucceeded JobPhase = "Succeeded"
+	JobPhaseFailed    JobPhase = "Failed"
+)
+
+// JobStatus displays the outcome of the workflow instance run by the "job" deployment model.
+type JobStatus struct {
+	// Name of the Job running the workflow instance
+	Name string `json:"name,omitempty"`
+	// TemplateHash identifies the Job spec the instance was run with
+	TemplateHash string `json:"templateHash,omitempty"`
+	// Phase of the workflow instance
+	Phase JobPhase `json:"phase,omitempty"`
+	// StartTime is the time the Job started
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time the instance finished, successfully or not
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Result is the workflow instance data reported by the run, or the error if it failed. Truncated to 4KB.
+	Result string `json:"result,omitempty"`
+}
+
+// IsFinished returns true if the workflow instance run to completion, successfully or not.
+func (j *JobStatus) IsFinished() bool {
+	return j != nil && (j.Phase == JobPhaseSucceeded || j.Phase == JobPhaseFailed)
+}
@@ -0,0 +1,95 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1alpha08
+
+const (
+	// DefaultEventDrivenMinReplicas keeps one workflow pod running to serve the HTTP endpoints while there's no backlog.
+	DefaultEventDrivenMinReplicas int32 = 1
+	// DefaultEventDrivenMaxReplicas is the maximum number of workflow pods when no maximum is configured.
+	DefaultEventDrivenMaxReplicas int32 = 10
+	// DefaultKafkaLagThreshold is the consumer lag per workflow pod that triggers a scale out.
+	DefaultKafkaLagThreshold int64 = 10
+)
+
+// EventDrivenAutoscalingSpec configures a KEDA ScaledObject that scales an event consuming workflow on its backlog.
+// Only used by the "kubernetes" deployment model and requires KEDA to be installed in the cluster.
+type EventDrivenAutoscalingSpec struct {
+	// MinReplicas is the minimum number of workflow pods. Defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="minReplicas"
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the maximum number of workflow pods. Defaults to 10.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="maxReplicas"
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+	// PollingInterval is the interval, in seconds, to check the backlog. Uses the KEDA default if not set.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="pollingInterval"
+	PollingInterval *int32 `json:"pollingInterval,omitempty"`
+	// CooldownPeriod is the period, in seconds, to wait after the last backlog check before scaling down to the minimum. Uses the KEDA default if not set.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="cooldownPeriod"
+	CooldownPeriod *int32 `json:"cooldownPeriod,omitempty"`
+	// Kafka configures how the backlog is read from the Kafka cluster backing the consumed events.
+	// When the events are consumed through a Knative Kafka Broker, the broker topic and the triggers consumer groups are used,
+	// otherwise the topic is the event type, named after the platform Kafka topic naming strategy, and the consumer group is "<namespace>.<workflow name>".
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="kafka"
+	Kafka KafkaLagScalerSpec `json:"kafka,omitempty"`
+}
+
+// KafkaLagScalerSpec configures the KEDA Kafka scaler.
+type KafkaLagScalerSpec struct {
+	// BootstrapServers is the comma separated list of the Kafka brokers.
+	// Defaults to the bootstrap servers of the platform Kafka eventing, required otherwise.
+	// +optional
+	BootstrapServers string `json:"bootstrapServers,omitempty"`
+	// LagThreshold is the consumer lag per workflow pod that triggers a scale out. Defaults to 10.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	LagThreshold *int64 `json:"lagThreshold,omitempty"`
+	// AuthenticationRef is the name of a KEDA TriggerAuthentication, in the workflow namespace, used to connect to the Kafka cluster.
+	// +optional
+	AuthenticationRef string `json:"authenticationRef,omitempty"`
+}
+
+// GetMinReplicas returns the configured minimum number of replicas or the default one.
+func (e *EventDrivenAutoscalingSpec) GetMinReplicas() int32 {
+	if e.MinReplicas == nil {
+		return DefaultEventDrivenMinReplicas
+	}
+	return *e.MinReplicas
+}
+
+// GetMaxReplicas returns the configured maximum number of replicas or the default one.
+func (e *EventDrivenAutoscalingSpec) GetMaxReplicas() int32 {
+	if e.MaxReplicas == nil {
+		return DefaultEventDrivenMaxReplicas
+	}
+	return *e.MaxReplicas
+}
+
+// GetLagThreshold returns the configured lag threshold or the default one.
+func (k *KafkaLagScalerSpec) GetLagThreshold() int64 {
+	if k.LagThreshold == nil {
+		return DefaultKafkaLagThreshold
+	}
+	return *k.LagThreshold
+}
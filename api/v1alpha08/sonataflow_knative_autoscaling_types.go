@@ -0,0 +1,77 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1alpha08
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KnativeAutoscalingMetric is the metric the Knative Pod Autoscaler scales the workflow revisions on.
+// +kubebuilder:validation:Enum=concurrency;rps
+type KnativeAutoscalingMetric string
+
+const (
+	// KnativeConcurrencyMetric scales on the number of in-flight requests per pod.
+	KnativeConcurrencyMetric KnativeAutoscalingMetric = "concurrency"
+	// KnativeRPSMetric scales on the number of requests per second per pod.
+	KnativeRPSMetric KnativeAutoscalingMetric = "rps"
+)
+
+// KnativeAutoscalingSpec configures the Knative Pod Autoscaler and the request concurrency of the workflow revisions.
+// Only used by the "knative" deployment model. Unset fields fall back to the Knative Serving defaults.
+type KnativeAutoscalingSpec struct {
+	// MinScale is the minimum number of pods of each revision. Zero allows the revision to scale to zero.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="minScale"
+	MinScale *int32 `json:"minScale,omitempty"`
+	// MaxScale is the maximum number of pods of each revision. Zero means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="maxScale"
+	MaxScale *int32 `json:"maxScale,omitempty"`
+	// InitialScale is the number of pods a new revision must reach before receiving traffic.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="initialScale"
+	InitialScale *int32 `json:"initialScale,omitempty"`
+	// Metric the revisions are scaled on.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="metric"
+	Metric KnativeAutoscalingMetric `json:"metric,omitempty"`
+	// Target is the soft limit of the metric per pod, for example, the in-flight requests per pod for the "concurrency" metric.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="target"
+	Target *int32 `json:"target,omitempty"`
+	// TargetUtilizationPercentage is the percent of the target the autoscaler actually aims for, leaving room for bursts.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="targetUtilizationPercentage"
+	TargetUtilizationPercentage *int32 `json:"targetUtilizationPercentage,omitempty"`
+	// ContainerConcurrency is the hard limit of in-flight requests per pod, additional requests are queued. Zero means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="containerConcurrency"
+	ContainerConcurrency *int64 `json:"containerConcurrency,omitempty"`
+	// ScaleDownDelay is how long the load must stay low before the revision is scaled down, up to one hour.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="scaleDownDelay"
+	ScaleDownDelay *metav1.Duration `json:"scaleDownDelay,omitempty"`
+}
@@ -0,0 +1,77 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1alpha08
+
+import corev1 "k8s.io/api/core/v1"
+
+// SecuritySpec secures the HTTP endpoints of a workflow or of the platform services.
+type SecuritySpec struct {
+	// OIDC authenticates the requests with the bearer tokens issued by an OpenID Connect provider, using the Quarkus OIDC extension.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="oidc"
+	OIDC *OIDCSpec `json:"oidc,omitempty"`
+}
+
+// OIDCSpec configures the Quarkus OIDC extension.
+type OIDCSpec struct {
+	// AuthServerURL is the URL of the OpenID Connect provider issuing the tokens, for example, https://keycloak.example.com/realms/sonataflow.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="authServerUrl"
+	AuthServerURL string `json:"authServerUrl"`
+	// ClientID is the client identifier of the application in the OpenID Connect provider.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="clientId"
+	ClientID string `json:"clientId"`
+	// ClientSecretRef selects the key of the Secret holding the client secret, if the client is confidential.
+	// The workflows started on a cron schedule authenticate with these client credentials.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="clientSecretRef"
+	ClientSecretRef *corev1.SecretKeySelector `json:"clientSecretRef,omitempty"`
+	// Paths are the secured paths with the roles allowed to call them.
+	// When empty, every workflow endpoint requires an authenticated request.
+	// The health endpoints are never secured, so that the probes keep working.
+	// Ignored by the platform services, which secure their own endpoints.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="paths"
+	Paths []OIDCPathSpec `json:"paths,omitempty"`
+}
+
+// OIDCPathSpec defines the access policy of a set of HTTP paths.
+type OIDCPathSpec struct {
+	// Path is the secured path, it can end with a wildcard, for example, /greeting/*.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="path"
+	Path string `json:"path"`
+	// Methods restricts the policy to the given HTTP methods. All the methods are secured if empty.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="methods"
+	Methods []string `json:"methods,omitempty"`
+	// Roles are the roles allowed to call the path. Any authenticated request is allowed if empty.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="roles"
+	Roles []string `json:"roles,omitempty"`
+	// Public permits the unauthenticated requests on the path.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="public"
+	Public bool `json:"public,omitempty"`
+}
+
+// GetOIDC returns the OIDC configuration of the given security, nil if there's none.
+func (s *SecuritySpec) GetOIDC() *OIDCSpec {
+	if s == nil {
+		return nil
+	}
+	return s.OIDC
+}
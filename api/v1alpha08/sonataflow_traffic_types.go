@@ -0,0 +1,171 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1alpha08
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DefaultCanaryStepPercent is the traffic percent shifted to the canary revision on every step.
+	DefaultCanaryStepPercent int64 = 20
+	// DefaultCanaryTag is the tag given to the canary revision so that it can be reached directly.
+	DefaultCanaryTag = "canary"
+)
+
+// DefaultCanaryStepInterval is how long a canary revision must stay ready before the next traffic step.
+var DefaultCanaryStepInterval = metav1.Duration{Duration: time.Minute}
+
+// KnativeTrafficSpec defines how the traffic is routed among the workflow Knative revisions.
+// Only used by the "knative" deployment model.
+type KnativeTrafficSpec struct {
+	// Targets is the list of traffic targets written to the Knative Service. When empty, all the traffic goes to the latest ready revision.
+	// The sum of the percents must be 100. Ignored if Canary is set.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="targets"
+	Targets []KnativeTrafficTarget `json:"targets,omitempty"`
+	// Canary enables the automated canary release. New revisions start with no traffic and are promoted in steps while they stay ready.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="canary"
+	Canary *KnativeCanarySpec `json:"canary,omitempty"`
+}
+
+// KnativeTrafficTarget routes a percent of the traffic to a given revision.
+type KnativeTrafficTarget struct {
+	// RevisionName pins the target to the given revision. When empty, the target follows the latest ready revision.
+	// +optional
+	RevisionName string `json:"revisionName,omitempty"`
+	// Tag exposes the target under a dedicated URL, for example, to test a revision that receives no traffic.
+	// +optional
+	Tag string `json:"tag,omitempty"`
+	// Percent of the traffic routed to this target.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percent *int64 `json:"percent,omitempty"`
+}
+
+// KnativeCanarySpec configures the automated canary release of new workflow revisions.
+type KnativeCanarySpec struct {
+	// StepPercent is the traffic percent shifted to the canary revision on every step. Defaults to 20.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	StepPercent *int64 `json:"stepPercent,omitempty"`
+	// StepInterval is how long the canary revision must stay ready before the next step. Defaults to 1m.
+	// +optional
+	StepInterval *metav1.Duration `json:"stepInterval,omitempty"`
+	// Tag given to the canary revision. Defaults to "canary".
+	// +optional
+	Tag string `json:"tag,omitempty"`
+}
+
+// GetStepPercent returns the configured step percent or the default one.
+func (c *KnativeCanarySpec) GetStepPercent() int64 {
+	if c.StepPercent == nil || *c.StepPercent <= 0 {
+		return DefaultCanaryStepPercent
+	}
+	return *c.StepPercent
+}
+
+// GetStepInterval returns the configured step interval or the default one.
+func (c *KnativeCanarySpec) GetStepInterval() metav1.Duration {
+	if c.StepInterval == nil {
+		return DefaultCanaryStepInterval
+	}
+	return *c.StepInterval
+}
+
+// GetTag returns the configured canary tag or the default one.
+func (c *KnativeCanarySpec) GetTag() string {
+	if len(c.Tag) == 0 {
+		return DefaultCanaryTag
+	}
+	return c.Tag
+}
+
+// KnativeCanaryStatus keeps track of an ongoing canary release.
+type KnativeCanaryStatus struct {
+	// StableRevision is the revision receiving the traffic not routed to the canary.
+	// +optional
+	StableRevision string `json:"stableRevision,omitempty"`
+	// CanaryRevision is the revision being promoted.
+	// +optional
+	CanaryRevision string `json:"canaryRevision,omitempty"`
+	// Percent of the traffic currently routed to the canary revision.
+	// +optional
+	Percent int64 `json:"percent,omitempty"`
+	// LastStepTime is the last time the canary traffic was increased.
+	// +optional
+	LastStepTime *metav1.Time `json:"lastStepTime,omitempty"`
+}
+
+// DeploymentColor identifies one of the two Deployments used by the blue/green rollout.
+// +kubebuilder:validation:Enum=blue;green
+type DeploymentColor string
+
+const (
+	BlueDeploymentColor  DeploymentColor = "blue"
+	GreenDeploymentColor DeploymentColor = "green"
+)
+
+// Other returns the opposite color. An empty color is followed by blue.
+func (c DeploymentColor) Other() DeploymentColor {
+	if c == BlueDeploymentColor {
+		return GreenDeploymentColor
+	}
+	return BlueDeploymentColor
+}
+
+// DefaultBlueGreenDrainPeriod is how long the previous Deployment is kept after the traffic switch.
+var DefaultBlueGreenDrainPeriod = metav1.Duration{Duration: 5 * time.Minute}
+
+// BlueGreenRolloutSpec configures the blue/green rollout of workflows deployed with the "kubernetes" deployment model.
+// Every change is deployed in a second Deployment. Once it's ready, the workflow Service is switched to it and the
+// previous Deployment is removed after the drain period.
+type BlueGreenRolloutSpec struct {
+	// DrainPeriod is how long the previous Deployment is kept after the traffic switch, so that in-flight requests can complete. Defaults to 5m.
+	// +optional
+	DrainPeriod *metav1.Duration `json:"drainPeriod,omitempty"`
+}
+
+// GetDrainPeriod returns the configured drain period or the default one.
+func (b *BlueGreenRolloutSpec) GetDrainPeriod() metav1.Duration {
+	if b.DrainPeriod == nil {
+		return DefaultBlueGreenDrainPeriod
+	}
+	return *b.DrainPeriod
+}
+
+// BlueGreenStatus keeps track of the blue/green rollout.
+type BlueGreenStatus struct {
+	// ActiveColor is the Deployment receiving the traffic.
+	// +optional
+	ActiveColor DeploymentColor `json:"activeColor,omitempty"`
+	// PreviewColor is the Deployment being rolled out, not receiving traffic yet.
+	// +optional
+	PreviewColor DeploymentColor `json:"previewColor,omitempty"`
+	// DrainingDeployment is the name of the previous Deployment waiting to be removed.
+	// +optional
+	DrainingDeployment string `json:"drainingDeployment,omitempty"`
+	// DrainingSince is the time the traffic was switched away from the DrainingDeployment.
+	// +optional
+	DrainingSince *metav1.Time `json:"drainingSince,omitempty"`
+}
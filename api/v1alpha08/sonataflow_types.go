@@ -32,7 +32,7 @@ import (
 const DefaultContainerName = "workflow"
 
 // DeploymentModel defines how a given pod will be deployed
-// +kubebuilder:validation:Enum=kubernetes;knative
+// +kubebuilder:validation:Enum=kubernetes;knative;job
 type DeploymentModel string
 
 const (
@@ -40,6 +40,8 @@ const (
 	KubernetesDeploymentModel DeploymentModel = "kubernetes"
 	// KnativeDeploymentModel defines a PodSpec to be deployed as a Knative Serving Service
 	KnativeDeploymentModel DeploymentModel = "knative"
+	// JobDeploymentModel defines a PodSpec to be run once to completion as a Kubernetes Job
+	JobDeploymentModel DeploymentModel = "job"
 )
 
 // FlowPodTemplateSpec is a special PodTemplateSpec designed for SonataFlow deployments
@@ -56,6 +58,35 @@ type FlowPodTemplateSpec struct {
 	// Defines the kind of deployment model for this pod spec. In dev profile, only "kubernetes" is valid.
 	// +optional
 	DeploymentModel DeploymentModel `json:"deploymentModel,omitempty"`
+	// Traffic defines how the traffic is split among the workflow revisions. Only used by the "knative" deployment model.
+	// +optional
+	Traffic *KnativeTrafficSpec `json:"traffic,omitempty"`
+	// Autoscaling configures the Knative Pod Autoscaler and the request concurrency of the workflow revisions.
+	// Only used by the "knative" deployment model. Takes precedence over the autoscaling annotations set on the Knative Service template.
+	// +optional
+	Autoscaling *KnativeAutoscalingSpec `json:"autoscaling,omitempty"`
+	// BlueGreen enables the blue/green rollout of new workflow revisions. Only used by the "kubernetes" deployment model, ignored in dev profile.
+	// +optional
+	BlueGreen *BlueGreenRolloutSpec `json:"blueGreen,omitempty"`
+	// DisruptionBudget configures the PodDisruptionBudget created when the workflow runs more than one replica.
+	// Only used by the "kubernetes" deployment model, ignored in dev profile.
+	// +optional
+	DisruptionBudget *PodDisruptionBudgetSpec `json:"disruptionBudget,omitempty"`
+	// TopologySpread configures the default topology spread constraints applied when the workflow runs more than one replica.
+	// Only used by the "kubernetes" deployment model.
+	// +optional
+	TopologySpread *TopologySpreadSpec `json:"topologySpread,omitempty"`
+	// EventDrivenAutoscaling scales the workflow on the backlog of its consumed events with KEDA.
+	// Only used by the "kubernetes" deployment model, ignored in dev profile or if the workflow doesn't consume events.
+	// +optional
+	EventDrivenAutoscaling *EventDrivenAutoscalingSpec `json:"eventDrivenAutoscaling,omitempty"`
+	// Job configures the Kubernetes Job running a single workflow instance to completion. Only used by the "job" deployment model.
+	// The workflow isn't exposed by a Service and can't consume events in this deployment model.
+	// +optional
+	Job *JobDeploymentSpec `json:"job,omitempty"`
+	// HealthProbes tunes the health probes of the workflow container, merged with the platform and the operator defaults.
+	// +optional
+	HealthProbes *HealthProbesSpec `json:"healthProbes,omitempty"`
 }
 
 // Flow describes the contents of the Workflow definition following the CNCF Serverless Workflow Specification.
@@ -69,7 +100,7 @@ type FlowPodTemplateSpec struct {
 //
 // - SpecVersion is in the CR's apiVersion, for example v1alpha08 means that it follows the specification version 0.8.
 type Flow struct {
-	// Workflow start definition.
+	// Workflow start definition. A cron schedule starts the workflow periodically through a CronJob created by the operator.
 	// +kubebuilder:validation:Schemaless
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +optional
@@ -108,8 +139,10 @@ type Flow struct {
 	// +optional
 	AutoRetries bool `json:"autoRetries,omitempty"`
 	// Auth definitions can be used to define authentication information that should be applied to resources defined
-	// in the operation property of function definitions. It is not used as authentication information for the
-	// function invocation, but just to access the resource containing the function invocation information.
+	// in the operation property of function definitions.
+	// The basic, bearer and OAuth2 client credentials definitions referenced by the OpenAPI functions are used to
+	// authenticate the function invocations, the definition name being the security scheme of the OpenAPI document.
+	// The values of the form $SECRET.<key> are read from the Kubernetes Secret named in the definition secret property.
 	// +kubebuilder:validation:Schemaless
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +optional
@@ -161,9 +194,33 @@ type SonataFlowSpec struct {
 	// Sink describes the sinkBinding details of this SonataFlow instance.
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="sink"
 	Sink *duckv1.Destination `json:"sink,omitempty"`
+	// Sinks describes the destinations of the events produced by this SonataFlow instance per event type. Every event type
+	// must match an event of kind produced in the flow. The produced events whose type is not listed are sent to the sink.
+	// +listType=map
+	// +listMapKey=eventType
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="sinks"
+	Sinks []SonataFlowSinkSpec `json:"sinks,omitempty"`
 	// Sources describes the list of sources used to create triggers for events consumed by this SonataFlow instance.
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="sources"
 	Sources []SonataFlowSourceSpec `json:"sources,omitempty"`
+	// Git describes a Git repository holding a full Quarkus/Maven project for this workflow.
+	// When set, the operator builds the project cloned from the repository instead of packaging the flow definition.
+	// Only used in the preview profile.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="git"
+	Git *GitSource `json:"git,omitempty"`
+	// Exposure creates an Ingress to reach the workflow from outside the cluster. Ignored in dev profile and in the "knative" deployment model.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="exposure"
+	Exposure *ExposureSpec `json:"exposure,omitempty"`
+	// HTTPRoute creates a Gateway API HTTPRoute attaching the workflow to a Gateway. Ignored in dev profile and in the "knative" deployment model.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="httpRoute"
+	HTTPRoute *HTTPRouteSpec `json:"httpRoute,omitempty"`
+	// Security secures the workflow endpoints. When not set, the security of the platform is used. Ignored in dev profile.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="security"
+	Security *SecuritySpec `json:"security,omitempty"`
 }
 
 // SonataFlowSourceSpec defines the desired state of a source used for trigger creation
@@ -175,6 +232,15 @@ type SonataFlowSourceSpec struct {
 	duckv1.Destination `json:",inline"`
 }
 
+// SonataFlowSinkSpec defines the destination of the events of a given type produced by the workflow
+// +k8s:openapi-gen=true
+type SonataFlowSinkSpec struct {
+	// Defines the eventType of the produced events sent to the destination
+	EventType string `json:"eventType"`
+	// Defines the destination, either a URI or a reference to a Knative Broker, a Knative Service, or a Kubernetes Service
+	duckv1.Destination `json:",inline"`
+}
+
 // SonataFlowStatus defines the observed state of SonataFlow
 // +k8s:openapi-gen=true
 type SonataFlowStatus struct {
@@ -202,6 +268,54 @@ type SonataFlowStatus struct {
 	Triggers []SonataFlowTriggerRef `json:"triggers,omitempty"`
 	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="flowRevision"
 	FlowCRC uint32 `json:"flowCRC,omitempty"`
+	// Canary displays the ongoing canary release, if any
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="canary"
+	Canary *KnativeCanaryStatus `json:"canary,omitempty"`
+	// BlueGreen displays the active and preview Deployments of the blue/green rollout, if enabled
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="blueGreen"
+	BlueGreen *BlueGreenStatus `json:"blueGreen,omitempty"`
+	// ObservedStartupSeconds is the longest startup time observed for the workflow pods, measured the first time each one
+	// became ready. Only observed when the adaptive startup is enabled, and used to derive the startup probe budget
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="observedStartupSeconds"
+	ObservedStartupSeconds int32 `json:"observedStartupSeconds,omitempty"`
+	// Schedule displays the last and next times the workflow is started, if the flow defines a cron start
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="schedule"
+	Schedule *ScheduleStatus `json:"schedule,omitempty"`
+	// Job displays the outcome of the workflow instance run by the "job" deployment model
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="job"
+	Job *JobStatus `json:"job,omitempty"`
+	// DevMode displays the developer tooling endpoints of a workflow running in the dev profile
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="devMode"
+	DevMode *DevModeStatus `json:"devMode,omitempty"`
+}
+
+// DevModeStatus displays the endpoints a developer can use to interact with a workflow running in the dev profile.
+type DevModeStatus struct {
+	// DevUIURL is the Quarkus Dev UI URL of the workflow
+	// +optional
+	DevUIURL *apis.URL `json:"devUIURL,omitempty"`
+	// SwaggerUIURL is the Swagger UI URL of the workflow
+	// +optional
+	SwaggerUIURL *apis.URL `json:"swaggerUIURL,omitempty"`
+	// DebugAddress is the "host:port" address a remote debugger can attach to, if the debug is enabled
+	// +optional
+	DebugAddress string `json:"debugAddress,omitempty"`
+	// ObservedRequests is the number of HTTP requests served by the workflow when the activity was last observed
+	// +optional
+	ObservedRequests int64 `json:"observedRequests,omitempty"`
+	// LastActivityTime is the last time the workflow served an HTTP request or had its spec changed
+	// +optional
+	LastActivityTime *metav1.Time `json:"lastActivityTime,omitempty"`
+}
+
+// ScheduleStatus displays the trigger times of a workflow started on a cron schedule.
+type ScheduleStatus struct {
+	// Cron expression currently used to start the workflow
+	Cron string `json:"cron,omitempty"`
+	// LastTriggerTime is the last time the workflow was started on schedule
+	LastTriggerTime *metav1.Time `json:"lastTriggerTime,omitempty"`
+	// NextTriggerTime is the next time the workflow will be started on schedule, empty once the schedule expired
+	NextTriggerTime *metav1.Time `json:"nextTriggerTime,omitempty"`
 }
 
 // SonataFlowTriggerRef defines a trigger created for the SonataFlow.
@@ -240,6 +354,12 @@ func (s *SonataFlowStatus) IsWaitingForDeployment() bool {
 	return cond.IsFalse() && cond.Reason == api.WaitingForDeploymentReason
 }
 
+// IsScaledDownWhileIdle indicates that the workflow running in dev mode was scaled to zero due to inactivity.
+func (s *SonataFlowStatus) IsScaledDownWhileIdle() bool {
+	cond := s.GetCondition(api.RunningConditionType)
+	return cond.IsFalse() && cond.Reason == api.ScaledDownWhileIdleReason
+}
+
 // IsChildObjectsProblem indicates a problem during objects creation during reconciliation
 // For example, a deployment that couldn't be created or a referenced object not found.
 func (s *SonataFlowStatus) IsChildObjectsProblem() bool {
@@ -298,6 +418,59 @@ func (s *SonataFlow) IsKnativeDeployment() bool {
 	return s.Spec.PodTemplate.DeploymentModel == KnativeDeploymentModel
 }
 
+// IsJobDeployment returns true if the workflow instance is run once to completion as a Kubernetes Job.
+func (s *SonataFlow) IsJobDeployment() bool {
+	return s.Spec.PodTemplate.DeploymentModel == JobDeploymentModel
+}
+
+// IsKubernetesDeployment returns true if the workflow is deployed as a vanilla Kubernetes Deployment.
+func (s *SonataFlow) IsKubernetesDeployment() bool {
+	return !s.IsKnativeDeployment() && !s.IsJobDeployment()
+}
+
+// IsBlueGreenDeployment returns true if the workflow is deployed as a Kubernetes Deployment with the blue/green rollout enabled.
+func (s *SonataFlow) IsBlueGreenDeployment() bool {
+	return s.IsKubernetesDeployment() && s.Spec.PodTemplate.BlueGreen != nil
+}
+
+// IsHighlyAvailable returns true if the workflow is deployed as a vanilla Kubernetes Deployment with more than one replica.
+func (s *SonataFlow) IsHighlyAvailable() bool {
+	return s.IsKubernetesDeployment() && s.Spec.PodTemplate.Replicas != nil && *s.Spec.PodTemplate.Replicas > 1
+}
+
+// IsDisruptionBudgetRequired returns true if a PodDisruptionBudget must protect the workflow pods.
+func (s *SonataFlow) IsDisruptionBudgetRequired() bool {
+	return s.IsHighlyAvailable() && (s.Spec.PodTemplate.DisruptionBudget == nil || !s.Spec.PodTemplate.DisruptionBudget.Disabled)
+}
+
+// IsTopologySpreadRequired returns true if the default topology spread constraints must be applied to the workflow pods.
+func (s *SonataFlow) IsTopologySpreadRequired() bool {
+	return s.IsHighlyAvailable() && (s.Spec.PodTemplate.TopologySpread == nil || !s.Spec.PodTemplate.TopologySpread.Disabled)
+}
+
+// GetStartCron returns the cron definition starting the workflow on schedule, nil if the flow doesn't define one.
+func (s *SonataFlow) GetStartCron() *cncfmodel.Cron {
+	if s.Spec.Flow.Start == nil || s.Spec.Flow.Start.Schedule == nil || s.Spec.Flow.Start.Schedule.Cron == nil ||
+		len(s.Spec.Flow.Start.Schedule.Cron.Expression) == 0 {
+		return nil
+	}
+	return s.Spec.Flow.Start.Schedule.Cron
+}
+
+// IsEventDrivenAutoscalingEnabled returns true if the workflow consumes events and must be scaled on their backlog.
+func (s *SonataFlow) IsEventDrivenAutoscalingEnabled() bool {
+	if !s.IsKubernetesDeployment() || s.Spec.PodTemplate.EventDrivenAutoscaling == nil {
+		return false
+	}
+	for _, event := range s.Spec.Flow.Events {
+		// the events are consumed unless stated otherwise
+		if event.Kind != cncfmodel.EventKindProduced {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *SonataFlow) HasContainerSpecImage() bool {
 	return len(s.Spec.PodTemplate.Container.Image) > 0
 }
@@ -33,6 +33,8 @@ type BuildPhase string
 const (
 	// BuildPhaseNone --
 	BuildPhaseNone BuildPhase = ""
+	// BuildPhaseQueued the build is waiting for a free build slot in the platform
+	BuildPhaseQueued BuildPhase = "Queued"
 	// BuildPhaseInitialization --
 	BuildPhaseInitialization BuildPhase = "Initialization"
 	// BuildPhaseScheduling --
@@ -84,12 +86,78 @@ type BuildTemplate struct {
 	// +optional
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Envs"
 	Envs []corev1.EnvVar `json:"envs,omitempty"`
+	// PodTemplate optional scheduling configuration for the builder pods.
+	// Takes precedence over the platform build configuration podTemplate.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="PodTemplate"
+	PodTemplate *BuilderPodTemplateSpec `json:"podTemplate,omitempty"`
+}
+
+// BuilderPodTemplateSpec describes how the builder pods are scheduled in the cluster.
+type BuilderPodTemplateSpec struct {
+	// NodeSelector is a selector which must be true for the builder pods to fit on a node.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations the builder pods tolerations.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Affinity the builder pods scheduling constraints.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// PriorityClassName the priority class of the builder pods.
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// ServiceAccountName the name of the ServiceAccount used to run the builder pods.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// ImagePullSecrets references to secrets in the same namespace to use for pulling the builder images.
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+}
+
+// GitSource describes a Git repository holding a Quarkus/Maven workflow project to build.
+// +k8s:openapi-gen=true
+type GitSource struct {
+	// URL of the Git repository to clone. For example, "https://github.com/my-org/my-workflow.git".
+	// +kubebuilder:validation:Required
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="URL"
+	URL string `json:"url"`
+	// Revision branch, tag, or commit to check out. If empty, the repository default branch is used.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Revision"
+	Revision string `json:"revision,omitempty"`
+	// ContextDir sub-directory within the repository where the project is located.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="ContextDir"
+	ContextDir string `json:"contextDir,omitempty"`
+	// SecretRef Secret in the build namespace holding the credentials to clone the repository.
+	// Basic authentication (kubernetes.io/basic-auth) and SSH (kubernetes.io/ssh-auth) secrets are supported.
+	// SSH secrets must also hold the `known_hosts` key used to verify the Git server host key.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="SecretRef"
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+	// InsecureSkipHostKeyVerification clones through SSH without verifying the Git server host key when the secret
+	// has no `known_hosts` key. Not recommended, any host could impersonate the Git server.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="InsecureSkipHostKeyVerification"
+	InsecureSkipHostKeyVerification bool `json:"insecureSkipHostKeyVerification,omitempty"`
 }
 
 // SonataFlowBuildSpec define the desired state of th SonataFlowBuild.
 // +k8s:openapi-gen=true
 type SonataFlowBuildSpec struct {
 	BuildTemplate `json:",inline"`
+	// Priority of this build when waiting in the platform build queue.
+	// Builds with higher priority are scheduled first, builds with the same priority follow the FIFO order.
+	// Copied from the `sonataflow.org/buildPriority` annotation of the SonataFlow.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Priority"
+	Priority int32 `json:"priority,omitempty"`
+	// Git when set, the build clones the given repository and builds the Quarkus/Maven project found there
+	// instead of packaging the workflow definition.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Git"
+	Git *GitSource `json:"git,omitempty"`
 }
 
 // SonataFlowBuildStatus defines the observed state of SonataFlowBuild
@@ -107,6 +175,30 @@ type SonataFlowBuildStatus struct {
 	// +optional
 	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="Error"
 	Error string `json:"error,omitempty"`
+	// GitCommit the commit SHA resolved from the Git source revision, if any
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="GitCommit"
+	GitCommit string `json:"gitCommit,omitempty"`
+	// RetryAttempts number of times the build has been retried after a failure
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="RetryAttempts"
+	RetryAttempts int32 `json:"retryAttempts,omitempty"`
+	// NextRetryAt when the failed build will be retried, empty if no retry is scheduled
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="NextRetryAt"
+	NextRetryAt *metav1.Time `json:"nextRetryAt,omitempty"`
+	// QueuePosition position of this build in the platform build queue while in the Queued phase, starting at 1.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="QueuePosition"
+	QueuePosition int32 `json:"queuePosition,omitempty"`
+	// QueuedAt the time this build entered the platform build queue.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="QueuedAt"
+	QueuedAt *metav1.Time `json:"queuedAt,omitempty"`
+	// CompletedAt the time this build last succeeded.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="CompletedAt"
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
 	// InnerBuild is a reference to an internal build object, which can be anything known only to internal builders.
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +optional
@@ -143,6 +235,8 @@ func (k *SonataFlowBuildStatus) GetInnerBuild(innerBuild interface{}) error {
 // +k8s:openapi-gen=true
 // +kubebuilder:printcolumn:name="Image",type=string,JSONPath=`.status.imageTag`
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.buildPhase`
+// +kubebuilder:printcolumn:name="Queue",type=integer,JSONPath=`.status.queuePosition`,priority=1
+// +kubebuilder:printcolumn:name="Retries",type=integer,JSONPath=`.status.retryAttempts`,priority=1
 // +kubebuilder:resource:shortName={"sfb", "sfbuild", "sfbuilds"}
 // +operator-sdk:csv:customresourcedefinitions:resources={{BuildConfig,build.openshift.io/v1,"An Openshift Build Config"}}
 // +operator-sdk:csv:customresourcedefinitions:displayName="SonataFlowBuild"
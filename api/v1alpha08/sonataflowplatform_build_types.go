@@ -22,6 +22,7 @@ package v1alpha08
 import (
 	"strconv"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -49,6 +50,68 @@ type BuildPlatformConfig struct {
 	BuildStrategyOptions map[string]string `json:"strategyOptions,omitempty"`
 	// Registry the registry where to publish the built image
 	Registry RegistrySpec `json:"registry,omitempty"`
+	// MaxConcurrentBuilds maximum number of workflow builds running at the same time in the platform namespace.
+	// Builds exceeding this limit are held in the Queued phase until a running build finishes.
+	// If empty or zero, no limit is applied.
+	// +kubebuilder:validation:Minimum=0
+	MaxConcurrentBuilds *int32 `json:"maxConcurrentBuilds,omitempty"`
+	// MaxConcurrentClusterBuilds maximum number of workflow builds running at the same time in the whole cluster.
+	// Only honored in the SonataFlowPlatform referenced by the active SonataFlowClusterPlatform.
+	// If empty or zero, no cluster-wide limit is applied.
+	// +kubebuilder:validation:Minimum=0
+	MaxConcurrentClusterBuilds *int32 `json:"maxConcurrentClusterBuilds,omitempty"`
+	// Maven configures how the workflow builds resolve Maven artifacts, for example, in disconnected clusters.
+	Maven *MavenBuildConfig `json:"maven,omitempty"`
+	// PodTemplate scheduling configuration for every builder pod in the platform, including the Kaniko cache warmer.
+	// The build template podTemplate fields take precedence over these ones.
+	PodTemplate *BuilderPodTemplateSpec `json:"podTemplate,omitempty"`
+	// RetryPolicy describes how failed workflow builds are retried. If empty, failed builds are not retried.
+	RetryPolicy *BuildRetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// BuildRetryPolicy describes how many times and when a failed build is retried
+type BuildRetryPolicy struct {
+	// MaxAttempts maximum number of retries for a failed build. Zero disables the retries.
+	// +kubebuilder:validation:Minimum=0
+	MaxAttempts int32 `json:"maxAttempts,omitempty"`
+	// InitialBackoff time to wait before the first retry. Defaults to 5s.
+	// +kubebuilder:validation:Format=duration
+	InitialBackoff *metav1.Duration `json:"initialBackoff,omitempty"`
+	// MaxBackoff maximum time to wait between retries. Defaults to 1m.
+	// +kubebuilder:validation:Format=duration
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+	// BackoffMultiplier factor applied to the time to wait after each retry. Defaults to 2.
+	// +kubebuilder:validation:Minimum=1
+	BackoffMultiplier *int32 `json:"backoffMultiplier,omitempty"`
+	// RetryableReasons regular expressions matched against the build failure message to consider the failure transient.
+	// For example, "(?i)timeout" or "connection reset". If empty, every failure is retried.
+	RetryableReasons []string `json:"retryableReasons,omitempty"`
+}
+
+// MavenBuildConfig describes the Maven settings, mirror, and local repository used by the workflow builds
+type MavenBuildConfig struct {
+	// Settings a Maven settings.xml file stored in a ConfigMap or Secret key in the platform namespace.
+	// The file is passed to the builds as the Maven user settings.
+	Settings *MavenSettingsSource `json:"settings,omitempty"`
+	// MirrorURL URL of a Maven repository mirroring every remote repository, for example, an internal Nexus instance.
+	MirrorURL string `json:"mirrorURL,omitempty"`
+	// LocalRepository PersistentVolumeClaim in the platform namespace holding the Maven local repository.
+	// The repository is shared by the builds, so artifacts are downloaded only once.
+	// Only supported by the operator build strategy, OpenShift builds can't mount persistent volumes.
+	LocalRepository string `json:"localRepository,omitempty"`
+	// Offline runs Maven in offline mode, every artifact must be available in the local repository.
+	// Ignored by OpenShift builds, which can't mount the local repository.
+	Offline bool `json:"offline,omitempty"`
+	// CABundle ConfigMap key holding the PEM encoded certificates trusted by Maven when connecting to the repositories.
+	CABundle *corev1.ConfigMapKeySelector `json:"caBundle,omitempty"`
+}
+
+// MavenSettingsSource describes the source of a Maven settings.xml file, only one of the references can be set
+type MavenSettingsSource struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap in the platform namespace
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+	// SecretKeyRef selects a key of a Secret in the platform namespace
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
 }
 
 // GetTimeout returns the specified duration or a default one
@@ -59,6 +122,60 @@ func (b *BuildPlatformConfig) GetTimeout() metav1.Duration {
 	return *b.Timeout
 }
 
+// GetMaxConcurrentBuilds returns the maximum number of concurrent builds in the platform namespace, zero means no limit
+func (b *BuildPlatformConfig) GetMaxConcurrentBuilds() int32 {
+	if b.MaxConcurrentBuilds == nil {
+		return 0
+	}
+	return *b.MaxConcurrentBuilds
+}
+
+// GetMaxConcurrentClusterBuilds returns the maximum number of concurrent builds in the cluster, zero means no limit
+func (b *BuildPlatformConfig) GetMaxConcurrentClusterBuilds() int32 {
+	if b.MaxConcurrentClusterBuilds == nil {
+		return 0
+	}
+	return *b.MaxConcurrentClusterBuilds
+}
+
+// GetBuilderPodTemplate returns the builder pod template for the given build template, merging its podTemplate with the
+// platform podTemplate. The fields set in the build template take precedence.
+func (b *BuildPlatformConfig) GetBuilderPodTemplate(template *BuildTemplate) *BuilderPodTemplateSpec {
+	var buildPodTemplate *BuilderPodTemplateSpec
+	if template != nil {
+		buildPodTemplate = template.PodTemplate
+	}
+	if b.PodTemplate == nil && buildPodTemplate == nil {
+		return nil
+	}
+	result := &BuilderPodTemplateSpec{}
+	if b.PodTemplate != nil {
+		result = b.PodTemplate.DeepCopy()
+	}
+	if buildPodTemplate == nil {
+		return result
+	}
+	if len(buildPodTemplate.NodeSelector) > 0 {
+		result.NodeSelector = buildPodTemplate.NodeSelector
+	}
+	if len(buildPodTemplate.Tolerations) > 0 {
+		result.Tolerations = buildPodTemplate.Tolerations
+	}
+	if buildPodTemplate.Affinity != nil {
+		result.Affinity = buildPodTemplate.Affinity
+	}
+	if len(buildPodTemplate.PriorityClassName) > 0 {
+		result.PriorityClassName = buildPodTemplate.PriorityClassName
+	}
+	if len(buildPodTemplate.ServiceAccountName) > 0 {
+		result.ServiceAccountName = buildPodTemplate.ServiceAccountName
+	}
+	if len(buildPodTemplate.ImagePullSecrets) > 0 {
+		result.ImagePullSecrets = buildPodTemplate.ImagePullSecrets
+	}
+	return result.DeepCopy()
+}
+
 // IsStrategyOptionEnabled return whether the BuildStrategyOptions is enabled or not
 func (b *BuildPlatformConfig) IsStrategyOptionEnabled(option string) bool {
 	if enabled, ok := b.BuildStrategyOptions[option]; ok {
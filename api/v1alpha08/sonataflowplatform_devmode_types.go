@@ -19,8 +19,48 @@
 
 package v1alpha08
 
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 // DevModePlatformSpec describes the devmode configuration for the given platform.
 type DevModePlatformSpec struct {
 	// Base image to run the Workflow in dev mode instead of the operator's default.
 	BaseImage string `json:"baseImage,omitempty"`
+	// Debug opens the JVM debug port of the workflows running in dev mode and exposes it through a dedicated Service,
+	// in this way an IDE can attach a remote debugger to the workflow running in the cluster.
+	// +optional
+	Debug *DevModeDebugSpec `json:"debug,omitempty"`
+	// Recovery tunes how the operator tries to recover the workflows running in dev mode from a failed deployment.
+	// +optional
+	Recovery *RecoveryPolicySpec `json:"recovery,omitempty"`
+	// IdleTimeout scales the workflows running in dev mode to zero once they haven't served any HTTP request for the given time.
+	// A change in the workflow spec or the "sonataflow.org/wakeUp" annotation scales them up again.
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+}
+
+const DefaultDevModeDebugPort int32 = 5005
+
+// DevModeDebugSpec describes the remote debugging configuration for the workflows running in dev mode.
+type DevModeDebugSpec struct {
+	// Port the JVM debug agent listens to, defaults to 5005.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// Suspend makes the JVM wait for a debugger to attach before starting the workflow.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+}
+
+// GetPort returns the configured debug port or the default one.
+func (d *DevModeDebugSpec) GetPort() int32 {
+	if d == nil || d.Port == nil {
+		return DefaultDevModeDebugPort
+	}
+	return *d.Port
+}
+
+// IsDebugEnabled returns true if the remote debugging is configured for the workflows running in dev mode.
+func (d *DevModePlatformSpec) IsDebugEnabled() bool {
+	return d.Debug != nil
 }
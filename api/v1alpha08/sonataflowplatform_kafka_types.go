@@ -0,0 +1,87 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1alpha08
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// KafkaSecurityProtocol is the protocol used to communicate with the Kafka brokers.
+// +kubebuilder:validation:Enum=PLAINTEXT;SSL;SASL_PLAINTEXT;SASL_SSL
+type KafkaSecurityProtocol string
+
+const (
+	KafkaPlaintextSecurityProtocol     KafkaSecurityProtocol = "PLAINTEXT"
+	KafkaSSLSecurityProtocol           KafkaSecurityProtocol = "SSL"
+	KafkaSASLPlaintextSecurityProtocol KafkaSecurityProtocol = "SASL_PLAINTEXT"
+	KafkaSASLSSLSecurityProtocol       KafkaSecurityProtocol = "SASL_SSL"
+)
+
+// KafkaTopicNamingStrategy defines how the Kafka topic of an event is named.
+// +kubebuilder:validation:Enum=EventType;NamespacePrefixed
+type KafkaTopicNamingStrategy string
+
+const (
+	// EventTypeKafkaTopicNaming names the topic after the event type, e.g. `org.acme.order.placed`.
+	EventTypeKafkaTopicNaming KafkaTopicNamingStrategy = "EventType"
+	// NamespacePrefixedKafkaTopicNaming prefixes the event type with the namespace of the platform holding the
+	// Kafka configuration, e.g. `my-namespace.org.acme.order.placed`.
+	NamespacePrefixedKafkaTopicNaming KafkaTopicNamingStrategy = "NamespacePrefixed"
+)
+
+// PlatformKafkaEventingSpec describes the Kafka cluster used to exchange the workflows, Data Index, and Jobs Service events.
+type PlatformKafkaEventingSpec struct {
+	// BootstrapServers is the comma separated list of Kafka brokers, e.g. `my-cluster-kafka-bootstrap.kafka:9092`.
+	// +kubebuilder:validation:MinLength=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="bootstrapServers"
+	BootstrapServers string `json:"bootstrapServers"`
+	// Security configures how the clients authenticate against the Kafka brokers.
+	// +optional
+	Security *KafkaSecuritySpec `json:"security,omitempty"`
+	// TopicNaming is the strategy used to name the topic of every event, defaults to EventType.
+	// +kubebuilder:default=EventType
+	// +optional
+	TopicNaming KafkaTopicNamingStrategy `json:"topicNaming,omitempty"`
+}
+
+// KafkaSecuritySpec describes the TLS and SASL settings of the Kafka clients.
+// The referenced secrets must exist in the namespace of every workflow and platform service using them.
+type KafkaSecuritySpec struct {
+	// Protocol used to communicate with the brokers, defaults to PLAINTEXT.
+	// +optional
+	Protocol KafkaSecurityProtocol `json:"protocol,omitempty"`
+	// SASLMechanism is the SASL mechanism used for the client connections, e.g. `SCRAM-SHA-512` or `PLAIN`.
+	// +optional
+	SASLMechanism string `json:"saslMechanism,omitempty"`
+	// SASLJAASConfigRef references the secret key holding the SASL JAAS configuration, e.g.
+	// `org.apache.kafka.common.security.scram.ScramLoginModule required username="user" password="secret";`.
+	// +optional
+	SASLJAASConfigRef *corev1.SecretKeySelector `json:"saslJaasConfigRef,omitempty"`
+	// CACertificateRef references the secret key holding the PEM encoded certificates trusted to verify the brokers,
+	// e.g. the `ca.crt` key of the Strimzi cluster CA secret.
+	// +optional
+	CACertificateRef *corev1.SecretKeySelector `json:"caCertificateRef,omitempty"`
+}
+
+// GetTopicNaming returns the topic naming strategy, defaults to EventType.
+func (k *PlatformKafkaEventingSpec) GetTopicNaming() KafkaTopicNamingStrategy {
+	if k == nil || len(k.TopicNaming) == 0 {
+		return EventTypeKafkaTopicNaming
+	}
+	return k.TopicNaming
+}
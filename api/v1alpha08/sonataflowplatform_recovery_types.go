@@ -0,0 +1,93 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package v1alpha08
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RecoveryAction is an action taken by the operator to recover a failed workflow deployment.
+// +kubebuilder:validation:Enum=RestartPod;RecreateDeployment;ResetConfigMaps
+type RecoveryAction string
+
+const (
+	// RestartPodRecoveryAction rolls out the workflow Deployment, restarting its pods.
+	RestartPodRecoveryAction RecoveryAction = "RestartPod"
+	// RecreateDeploymentRecoveryAction deletes the workflow Deployment, so the reconciliation creates it again.
+	RecreateDeploymentRecoveryAction RecoveryAction = "RecreateDeployment"
+	// ResetConfigMapsRecoveryAction deletes the ConfigMaps generated for the workflow, so the reconciliation creates them again,
+	// and rolls out the workflow Deployment. The user properties ConfigMap is never removed.
+	ResetConfigMapsRecoveryAction RecoveryAction = "ResetConfigMaps"
+)
+
+const (
+	DefaultRecoveryMaxAttempts int32 = 3
+	DefaultRecoveryBackoff           = 10 * time.Minute
+	// MaxRecoveryBackoff caps the time to wait between two recovery attempts.
+	MaxRecoveryBackoff = 24 * time.Hour
+)
+
+// RecoveryPolicySpec describes how the operator tries to recover a workflow whose deployment has failed.
+type RecoveryPolicySpec struct {
+	// MaxAttempts is the number of recovery attempts before giving up, defaults to 3.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxAttempts *int32 `json:"maxAttempts,omitempty"`
+	// Backoff is the time to wait after the first recovery attempt before trying again, doubled after every attempt
+	// up to 24 hours. Defaults to 10 minutes.
+	// +optional
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+	// Actions taken on every recovery attempt, the first attempt takes the first action, the second attempt the second one,
+	// and so on. Once the list is over, the last action is repeated. Defaults to RestartPod.
+	// +optional
+	Actions []RecoveryAction `json:"actions,omitempty"`
+}
+
+// GetMaxAttempts returns the configured number of recovery attempts or the default one.
+func (r *RecoveryPolicySpec) GetMaxAttempts() int {
+	if r == nil || r.MaxAttempts == nil {
+		return int(DefaultRecoveryMaxAttempts)
+	}
+	return int(*r.MaxAttempts)
+}
+
+// GetBackoff returns the time to wait after the given number of recovery attempts, never longer than MaxRecoveryBackoff.
+func (r *RecoveryPolicySpec) GetBackoff(attempts int) time.Duration {
+	backoff := DefaultRecoveryBackoff
+	if r != nil && r.Backoff != nil {
+		backoff = r.Backoff.Duration
+	}
+	for i := 1; i < attempts && backoff < MaxRecoveryBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, MaxRecoveryBackoff)
+}
+
+// GetAction returns the action to take on the given recovery attempt, starting from zero.
+func (r *RecoveryPolicySpec) GetAction(attempt int) RecoveryAction {
+	if r == nil || len(r.Actions) == 0 {
+		return RestartPodRecoveryAction
+	}
+	if attempt >= len(r.Actions) {
+		return r.Actions[len(r.Actions)-1]
+	}
+	return r.Actions[attempt]
+}
@@ -66,4 +66,12 @@ type ServiceSpec struct {
 	// PodTemplate describes the deployment details of this platform service instance.
 	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="podTemplate"
 	PodTemplate PodTemplateSpec `json:"podTemplate,omitempty"`
+	// Exposure creates an Ingress to reach this platform service from outside the cluster.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="exposure"
+	Exposure *ExposureSpec `json:"exposure,omitempty"`
+	// HTTPRoute creates a Gateway API HTTPRoute attaching this platform service to a Gateway.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="httpRoute"
+	HTTPRoute *HTTPRouteSpec `json:"httpRoute,omitempty"`
 }
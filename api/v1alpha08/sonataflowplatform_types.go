@@ -66,15 +66,49 @@ type SonataFlowPlatformSpec struct {
 	// Settings for Prometheus monitoring
 	// +optional
 	Monitoring *PlatformMonitoringOptionsSpec `json:"monitoring,omitempty"`
+	// NetworkPolicies generates least privilege NetworkPolicies for the workflows and the services of this platform.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="NetworkPolicies"
+	NetworkPolicies *PlatformNetworkPoliciesSpec `json:"networkPolicies,omitempty"`
+	// HealthProbes tunes the health probes of the workflows deployed in this platform.
+	// Every workflow can still override this tuning in its own pod template.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="HealthProbes"
+	HealthProbes *HealthProbesSpec `json:"healthProbes,omitempty"`
+	// Security secures the endpoints of the platform services and, by default, of the workflows deployed in this platform.
+	// The Data Index secures its GraphQL API, and the Jobs Service its jobs API when the jobs are sourced from Knative Eventing.
+	// Every workflow can still define its own security.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Security"
+	Security *SecuritySpec `json:"security,omitempty"`
+	// TLS serves the workflows and the services of this platform over HTTPS with the certificates requested from cert-manager.
+	// Workflows deployed with Knative Serving rely on the Knative TLS configuration instead.
+	// The HTTP port keeps serving the in-cluster calls between the workflows and the services.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TLS"
+	TLS *PlatformTLSSpec `json:"tls,omitempty"`
+	// Recovery enables the recovery of the workflows deployed in this platform, without the dev profile, from a failed deployment.
+	// Only workflows using the "kubernetes" deployment model without blue-green rollouts are recovered.
+	// Workflows running in dev mode are always recovered, see DevMode.Recovery.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Recovery"
+	Recovery *RecoveryPolicySpec `json:"recovery,omitempty"`
 }
 
-// PlatformEventingSpec specifies the Knative Eventing integration details in the platform.
+// PlatformEventingSpec specifies the eventing integration details in the platform, either Knative Eventing or Kafka.
 // +k8s:openapi-gen=true
+// +kubebuilder:validation:XValidation:rule="!(has(self.broker) && has(self.kafka))",message="broker and kafka are mutually exclusive"
 type PlatformEventingSpec struct {
 	// Broker to communicate with workflow deployment.  It can be the default broker when the workflow, Dataindex, or Jobservice does not have a sink or source specified.
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="broker"
 	Broker *duckv1.Destination `json:"broker,omitempty"`
+	// Kafka cluster used to exchange events when Knative Eventing is not available. The workflows without a sink, the Data Index,
+	// and the Jobs Service produce and consume their events directly from Kafka topics.
+	// Workflows running in the dev profile are not wired to Kafka.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="kafka"
+	Kafka *PlatformKafkaEventingSpec `json:"kafka,omitempty"`
 }
 
 // PlatformMonitoringOptionsSpec specifies the settings for monitoring
@@ -86,6 +120,50 @@ type PlatformMonitoringOptionsSpec struct {
 	Enabled bool `json:"enabled,omitempty"`
 }
 
+// PlatformNetworkPoliciesSpec specifies the NetworkPolicies generated for the workflows and the platform services.
+// The allowed traffic is derived from the platform services, the Knative Eventing integration, the persistence and the services
+// discovered by every workflow, so that the policies are regenerated whenever these change.
+// +k8s:openapi-gen=true
+type PlatformNetworkPoliciesSpec struct {
+	// Enabled indicates whether the NetworkPolicies are generated
+	// +optional
+	// +default: false
+	Enabled bool `json:"enabled,omitempty"`
+	// IngressNamespaces are the namespaces allowed to reach the HTTP port of the workflows and the platform services,
+	// for example, the namespaces of the ingress controllers or of Prometheus.
+	// When empty, the exposed workflows and platform services accept traffic from any source on their HTTP port.
+	// +optional
+	IngressNamespaces []string `json:"ingressNamespaces,omitempty"`
+	// EgressCIDRs are the networks outside the cluster the workflows are allowed to call, for example, the external OpenAPI services.
+	// +optional
+	EgressCIDRs []string `json:"egressCIDRs,omitempty"`
+}
+
+// PlatformTLSSpec configures the certificates requested from cert-manager for the workflows and the services of the platform.
+// Every certificate is stored in a Secret named after the workflow or the service, with the "-tls" suffix, and is valid
+// for the in-cluster names of its Service.
+// The clients calling the HTTPS endpoints, including the workflows calling the platform services, must trust the issuer
+// certificate authority.
+// +k8s:openapi-gen=true
+type PlatformTLSSpec struct {
+	// IssuerRef references the cert-manager issuer signing the certificates.
+	IssuerRef CertManagerIssuerRef `json:"issuerRef"`
+	// Duration is the requested lifetime of the certificates. Defaults to the cert-manager one.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// CertManagerIssuerRef references a cert-manager Issuer or ClusterIssuer.
+type CertManagerIssuerRef struct {
+	// Name of the issuer.
+	Name string `json:"name"`
+	// Kind of the issuer, an Issuer in the namespace of the certificate or a ClusterIssuer.
+	// +optional
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	// +kubebuilder:default=Issuer
+	Kind string `json:"kind,omitempty"`
+}
+
 // PlatformCluster is the kind of orchestration cluster the platform is installed into
 // +kubebuilder:validation:Enum=kubernetes;openshift
 type PlatformCluster string
@@ -123,6 +201,9 @@ type SonataFlowPlatformStatus struct {
 	// Triggers list of triggers created for the SonataFlowPlatform
 	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="triggers"
 	Triggers []SonataFlowPlatformTriggerRef `json:"triggers,omitempty"`
+	// Endpoints displays the external URLs of the platform services exposed with an Ingress
+	//+operator-sdk:csv:customresourcedefinitions:type=status,displayName="endpoints"
+	Endpoints *PlatformServicesStatus `json:"endpoints,omitempty"`
 }
 
 // SonataFlowPlatformTriggerRef defines a trigger created for the SonataFlowPlatform.
@@ -162,6 +243,16 @@ type PlatformServiceRefStatus struct {
 	Url string `json:"url,omitempty"`
 }
 
+// IsNetworkPoliciesEnabled returns true if NetworkPolicies must be generated for the workflows and the services of this platform.
+func (in *SonataFlowPlatform) IsNetworkPoliciesEnabled() bool {
+	return in != nil && in.Spec.NetworkPolicies != nil && in.Spec.NetworkPolicies.Enabled
+}
+
+// IsTLSEnabled returns true if the workflows and the services of this platform are served over HTTPS.
+func (in *SonataFlowPlatform) IsTLSEnabled() bool {
+	return in != nil && in.Spec.TLS != nil
+}
+
 func (in *SonataFlowPlatformStatus) GetTopLevelConditionType() api.ConditionType {
 	return api.SucceedConditionType
 }
@@ -24,10 +24,50 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenRolloutSpec) DeepCopyInto(out *BlueGreenRolloutSpec) {
+	*out = *in
+	if in.DrainPeriod != nil {
+		in, out := &in.DrainPeriod, &out.DrainPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenRolloutSpec.
+func (in *BlueGreenRolloutSpec) DeepCopy() *BlueGreenRolloutSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenRolloutSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenStatus) DeepCopyInto(out *BlueGreenStatus) {
+	*out = *in
+	if in.DrainingSince != nil {
+		in, out := &in.DrainingSince, &out.DrainingSince
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenStatus.
+func (in *BlueGreenStatus) DeepCopy() *BlueGreenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildPlatformConfig) DeepCopyInto(out *BuildPlatformConfig) {
 	*out = *in
@@ -44,6 +84,31 @@ func (in *BuildPlatformConfig) DeepCopyInto(out *BuildPlatformConfig) {
 		}
 	}
 	out.Registry = in.Registry
+	if in.MaxConcurrentBuilds != nil {
+		in, out := &in.MaxConcurrentBuilds, &out.MaxConcurrentBuilds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxConcurrentClusterBuilds != nil {
+		in, out := &in.MaxConcurrentClusterBuilds, &out.MaxConcurrentClusterBuilds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Maven != nil {
+		in, out := &in.Maven, &out.Maven
+		*out = new(MavenBuildConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(BuilderPodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(BuildRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildPlatformConfig.
@@ -73,6 +138,41 @@ func (in *BuildPlatformSpec) DeepCopy() *BuildPlatformSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuildRetryPolicy) DeepCopyInto(out *BuildRetryPolicy) {
+	*out = *in
+	if in.InitialBackoff != nil {
+		in, out := &in.InitialBackoff, &out.InitialBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.BackoffMultiplier != nil {
+		in, out := &in.BackoffMultiplier, &out.BackoffMultiplier
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetryableReasons != nil {
+		in, out := &in.RetryableReasons, &out.RetryableReasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildRetryPolicy.
+func (in *BuildRetryPolicy) DeepCopy() *BuildRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(BuildRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildTemplate) DeepCopyInto(out *BuildTemplate) {
 	*out = *in
@@ -97,6 +197,11 @@ func (in *BuildTemplate) DeepCopyInto(out *BuildTemplate) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(BuilderPodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildTemplate.
@@ -109,6 +214,60 @@ func (in *BuildTemplate) DeepCopy() *BuildTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BuilderPodTemplateSpec) DeepCopyInto(out *BuilderPodTemplateSpec) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuilderPodTemplateSpec.
+func (in *BuilderPodTemplateSpec) DeepCopy() *BuilderPodTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BuilderPodTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerIssuerRef) DeepCopyInto(out *CertManagerIssuerRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerRef.
+func (in *CertManagerIssuerRef) DeepCopy() *CertManagerIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigMapWorkflowResource) DeepCopyInto(out *ConfigMapWorkflowResource) {
 	*out = *in
@@ -233,9 +392,44 @@ func (in *DataIndexServiceSpec) DeepCopy() *DataIndexServiceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DevModeDebugSpec) DeepCopyInto(out *DevModeDebugSpec) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DevModeDebugSpec.
+func (in *DevModeDebugSpec) DeepCopy() *DevModeDebugSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DevModeDebugSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DevModePlatformSpec) DeepCopyInto(out *DevModePlatformSpec) {
 	*out = *in
+	if in.Debug != nil {
+		in, out := &in.Debug, &out.Debug
+		*out = new(DevModeDebugSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Recovery != nil {
+		in, out := &in.Recovery, &out.Recovery
+		*out = new(RecoveryPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DevModePlatformSpec.
@@ -248,6 +442,98 @@ func (in *DevModePlatformSpec) DeepCopy() *DevModePlatformSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DevModeStatus) DeepCopyInto(out *DevModeStatus) {
+	*out = *in
+	if in.DevUIURL != nil {
+		in, out := &in.DevUIURL, &out.DevUIURL
+		*out = new(apis.URL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SwaggerUIURL != nil {
+		in, out := &in.SwaggerUIURL, &out.SwaggerUIURL
+		*out = new(apis.URL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastActivityTime != nil {
+		in, out := &in.LastActivityTime, &out.LastActivityTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DevModeStatus.
+func (in *DevModeStatus) DeepCopy() *DevModeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DevModeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventDrivenAutoscalingSpec) DeepCopyInto(out *EventDrivenAutoscalingSpec) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PollingInterval != nil {
+		in, out := &in.PollingInterval, &out.PollingInterval
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CooldownPeriod != nil {
+		in, out := &in.CooldownPeriod, &out.CooldownPeriod
+		*out = new(int32)
+		**out = **in
+	}
+	in.Kafka.DeepCopyInto(&out.Kafka)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventDrivenAutoscalingSpec.
+func (in *EventDrivenAutoscalingSpec) DeepCopy() *EventDrivenAutoscalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EventDrivenAutoscalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExposureSpec) DeepCopyInto(out *ExposureSpec) {
+	*out = *in
+	if in.IngressClassName != nil {
+		in, out := &in.IngressClassName, &out.IngressClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExposureSpec.
+func (in *ExposureSpec) DeepCopy() *ExposureSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExposureSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Flow) DeepCopyInto(out *Flow) {
 	*out = *in
@@ -321,69 +607,571 @@ func (in *Flow) DeepCopyInto(out *Flow) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Retries != nil {
-		in, out := &in.Retries, &out.Retries
-		*out = make(model.Retries, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = make(model.Retries, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Flow.
+func (in *Flow) DeepCopy() *Flow {
+	if in == nil {
+		return nil
+	}
+	out := new(Flow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlowPodTemplateSpec) DeepCopyInto(out *FlowPodTemplateSpec) {
+	*out = *in
+	in.Container.DeepCopyInto(&out.Container)
+	in.PodSpec.DeepCopyInto(&out.PodSpec)
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Traffic != nil {
+		in, out := &in.Traffic, &out.Traffic
+		*out = new(KnativeTrafficSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(KnativeAutoscalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlueGreen != nil {
+		in, out := &in.BlueGreen, &out.BlueGreen
+		*out = new(BlueGreenRolloutSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DisruptionBudget != nil {
+		in, out := &in.DisruptionBudget, &out.DisruptionBudget
+		*out = new(PodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpread != nil {
+		in, out := &in.TopologySpread, &out.TopologySpread
+		*out = new(TopologySpreadSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventDrivenAutoscaling != nil {
+		in, out := &in.EventDrivenAutoscaling, &out.EventDrivenAutoscaling
+		*out = new(EventDrivenAutoscalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(JobDeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthProbes != nil {
+		in, out := &in.HealthProbes, &out.HealthProbes
+		*out = new(HealthProbesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowPodTemplateSpec.
+func (in *FlowPodTemplateSpec) DeepCopy() *FlowPodTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FlowPodTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayReference) DeepCopyInto(out *GatewayReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayReference.
+func (in *GatewayReference) DeepCopy() *GatewayReference {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitSource) DeepCopyInto(out *GitSource) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSource.
+func (in *GitSource) DeepCopy() *GitSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GitSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRouteSpec) DeepCopyInto(out *HTTPRouteSpec) {
+	*out = *in
+	out.GatewayRef = in.GatewayRef
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteSpec.
+func (in *HTTPRouteSpec) DeepCopy() *HTTPRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthProbesSpec) DeepCopyInto(out *HealthProbesSpec) {
+	*out = *in
+	if in.Liveness != nil {
+		in, out := &in.Liveness, &out.Liveness
+		*out = new(ProbeTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Readiness != nil {
+		in, out := &in.Readiness, &out.Readiness
+		*out = new(ProbeTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Startup != nil {
+		in, out := &in.Startup, &out.Startup
+		*out = new(ProbeTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdaptiveStartup != nil {
+		in, out := &in.AdaptiveStartup, &out.AdaptiveStartup
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthProbesSpec.
+func (in *HealthProbesSpec) DeepCopy() *HealthProbesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthProbesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobDeploymentSpec) DeepCopyInto(out *JobDeploymentSpec) {
+	*out = *in
+	if in.Input != nil {
+		in, out := &in.Input, &out.Input
+		*out = new(JobInputSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobDeploymentSpec.
+func (in *JobDeploymentSpec) DeepCopy() *JobDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JobDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobInputSource) DeepCopyInto(out *JobInputSource) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobInputSource.
+func (in *JobInputSource) DeepCopy() *JobInputSource {
+	if in == nil {
+		return nil
+	}
+	out := new(JobInputSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobServiceServiceSpec) DeepCopyInto(out *JobServiceServiceSpec) {
+	*out = *in
+	in.ServiceSpec.DeepCopyInto(&out.ServiceSpec)
+	if in.Sink != nil {
+		in, out := &in.Sink, &out.Sink
+		*out = new(duckv1.Destination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(duckv1.Destination)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobServiceServiceSpec.
+func (in *JobServiceServiceSpec) DeepCopy() *JobServiceServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JobServiceServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobStatus) DeepCopyInto(out *JobStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobStatus.
+func (in *JobStatus) DeepCopy() *JobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaLagScalerSpec) DeepCopyInto(out *KafkaLagScalerSpec) {
+	*out = *in
+	if in.LagThreshold != nil {
+		in, out := &in.LagThreshold, &out.LagThreshold
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaLagScalerSpec.
+func (in *KafkaLagScalerSpec) DeepCopy() *KafkaLagScalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaLagScalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSecuritySpec) DeepCopyInto(out *KafkaSecuritySpec) {
+	*out = *in
+	if in.SASLJAASConfigRef != nil {
+		in, out := &in.SASLJAASConfigRef, &out.SASLJAASConfigRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CACertificateRef != nil {
+		in, out := &in.CACertificateRef, &out.CACertificateRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSecuritySpec.
+func (in *KafkaSecuritySpec) DeepCopy() *KafkaSecuritySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSecuritySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnativeAutoscalingSpec) DeepCopyInto(out *KnativeAutoscalingSpec) {
+	*out = *in
+	if in.MinScale != nil {
+		in, out := &in.MinScale, &out.MinScale
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxScale != nil {
+		in, out := &in.MaxScale, &out.MaxScale
+		*out = new(int32)
+		**out = **in
+	}
+	if in.InitialScale != nil {
+		in, out := &in.InitialScale, &out.InitialScale
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetUtilizationPercentage != nil {
+		in, out := &in.TargetUtilizationPercentage, &out.TargetUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ContainerConcurrency != nil {
+		in, out := &in.ContainerConcurrency, &out.ContainerConcurrency
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ScaleDownDelay != nil {
+		in, out := &in.ScaleDownDelay, &out.ScaleDownDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnativeAutoscalingSpec.
+func (in *KnativeAutoscalingSpec) DeepCopy() *KnativeAutoscalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KnativeAutoscalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnativeCanarySpec) DeepCopyInto(out *KnativeCanarySpec) {
+	*out = *in
+	if in.StepPercent != nil {
+		in, out := &in.StepPercent, &out.StepPercent
+		*out = new(int64)
+		**out = **in
+	}
+	if in.StepInterval != nil {
+		in, out := &in.StepInterval, &out.StepInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnativeCanarySpec.
+func (in *KnativeCanarySpec) DeepCopy() *KnativeCanarySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KnativeCanarySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnativeCanaryStatus) DeepCopyInto(out *KnativeCanaryStatus) {
+	*out = *in
+	if in.LastStepTime != nil {
+		in, out := &in.LastStepTime, &out.LastStepTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnativeCanaryStatus.
+func (in *KnativeCanaryStatus) DeepCopy() *KnativeCanaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KnativeCanaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnativeTrafficSpec) DeepCopyInto(out *KnativeTrafficSpec) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]KnativeTrafficTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(KnativeCanarySpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnativeTrafficSpec.
+func (in *KnativeTrafficSpec) DeepCopy() *KnativeTrafficSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KnativeTrafficSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KnativeTrafficTarget) DeepCopyInto(out *KnativeTrafficTarget) {
+	*out = *in
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KnativeTrafficTarget.
+func (in *KnativeTrafficTarget) DeepCopy() *KnativeTrafficTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(KnativeTrafficTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MavenBuildConfig) DeepCopyInto(out *MavenBuildConfig) {
+	*out = *in
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = new(MavenSettingsSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MavenBuildConfig.
+func (in *MavenBuildConfig) DeepCopy() *MavenBuildConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MavenBuildConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MavenSettingsSource) DeepCopyInto(out *MavenSettingsSource) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Flow.
-func (in *Flow) DeepCopy() *Flow {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MavenSettingsSource.
+func (in *MavenSettingsSource) DeepCopy() *MavenSettingsSource {
 	if in == nil {
 		return nil
 	}
-	out := new(Flow)
+	out := new(MavenSettingsSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FlowPodTemplateSpec) DeepCopyInto(out *FlowPodTemplateSpec) {
+func (in *OIDCPathSpec) DeepCopyInto(out *OIDCPathSpec) {
 	*out = *in
-	in.Container.DeepCopyInto(&out.Container)
-	in.PodSpec.DeepCopyInto(&out.PodSpec)
-	if in.Replicas != nil {
-		in, out := &in.Replicas, &out.Replicas
-		*out = new(int32)
-		**out = **in
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowPodTemplateSpec.
-func (in *FlowPodTemplateSpec) DeepCopy() *FlowPodTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCPathSpec.
+func (in *OIDCPathSpec) DeepCopy() *OIDCPathSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(FlowPodTemplateSpec)
+	out := new(OIDCPathSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JobServiceServiceSpec) DeepCopyInto(out *JobServiceServiceSpec) {
+func (in *OIDCSpec) DeepCopyInto(out *OIDCSpec) {
 	*out = *in
-	in.ServiceSpec.DeepCopyInto(&out.ServiceSpec)
-	if in.Sink != nil {
-		in, out := &in.Sink, &out.Sink
-		*out = new(duckv1.Destination)
+	if in.ClientSecretRef != nil {
+		in, out := &in.ClientSecretRef, &out.ClientSecretRef
+		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Source != nil {
-		in, out := &in.Source, &out.Source
-		*out = new(duckv1.Destination)
-		(*in).DeepCopyInto(*out)
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]OIDCPathSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobServiceServiceSpec.
-func (in *JobServiceServiceSpec) DeepCopy() *JobServiceServiceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCSpec.
+func (in *OIDCSpec) DeepCopy() *OIDCSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(JobServiceServiceSpec)
+	out := new(OIDCSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -437,6 +1225,11 @@ func (in *PlatformEventingSpec) DeepCopyInto(out *PlatformEventingSpec) {
 		*out = new(duckv1.Destination)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(PlatformKafkaEventingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformEventingSpec.
@@ -449,6 +1242,26 @@ func (in *PlatformEventingSpec) DeepCopy() *PlatformEventingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformKafkaEventingSpec) DeepCopyInto(out *PlatformKafkaEventingSpec) {
+	*out = *in
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(KafkaSecuritySpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformKafkaEventingSpec.
+func (in *PlatformKafkaEventingSpec) DeepCopy() *PlatformKafkaEventingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformKafkaEventingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlatformMonitoringOptionsSpec) DeepCopyInto(out *PlatformMonitoringOptionsSpec) {
 	*out = *in
@@ -464,6 +1277,31 @@ func (in *PlatformMonitoringOptionsSpec) DeepCopy() *PlatformMonitoringOptionsSp
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformNetworkPoliciesSpec) DeepCopyInto(out *PlatformNetworkPoliciesSpec) {
+	*out = *in
+	if in.IngressNamespaces != nil {
+		in, out := &in.IngressNamespaces, &out.IngressNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EgressCIDRs != nil {
+		in, out := &in.EgressCIDRs, &out.EgressCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformNetworkPoliciesSpec.
+func (in *PlatformNetworkPoliciesSpec) DeepCopy() *PlatformNetworkPoliciesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformNetworkPoliciesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlatformPersistenceOptionsSpec) DeepCopyInto(out *PlatformPersistenceOptionsSpec) {
 	*out = *in
@@ -545,6 +1383,52 @@ func (in *PlatformServicesStatus) DeepCopy() *PlatformServicesStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlatformTLSSpec) DeepCopyInto(out *PlatformTLSSpec) {
+	*out = *in
+	out.IssuerRef = in.IssuerRef
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlatformTLSSpec.
+func (in *PlatformTLSSpec) DeepCopy() *PlatformTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlatformTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudgetSpec) DeepCopyInto(out *PodDisruptionBudgetSpec) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudgetSpec.
+func (in *PodDisruptionBudgetSpec) DeepCopy() *PodDisruptionBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSpec) DeepCopyInto(out *PodSpec) {
 	*out = *in
@@ -765,6 +1649,41 @@ func (in *PostgreSQLServiceOptions) DeepCopy() *PostgreSQLServiceOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeTuningSpec) DeepCopyInto(out *ProbeTuningSpec) {
+	*out = *in
+	if in.InitialDelaySeconds != nil {
+		in, out := &in.InitialDelaySeconds, &out.InitialDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PeriodSeconds != nil {
+		in, out := &in.PeriodSeconds, &out.PeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeTuningSpec.
+func (in *ProbeTuningSpec) DeepCopy() *ProbeTuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeTuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PropertyPlatformSpec) DeepCopyInto(out *PropertyPlatformSpec) {
 	*out = *in
@@ -832,6 +1751,36 @@ func (in *PropertyVarSource) DeepCopy() *PropertyVarSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecoveryPolicySpec) DeepCopyInto(out *RecoveryPolicySpec) {
+	*out = *in
+	if in.MaxAttempts != nil {
+		in, out := &in.MaxAttempts, &out.MaxAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]RecoveryAction, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecoveryPolicySpec.
+func (in *RecoveryPolicySpec) DeepCopy() *RecoveryPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RecoveryPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegistrySpec) DeepCopyInto(out *RegistrySpec) {
 	*out = *in
@@ -867,6 +1816,49 @@ func (in *SQLServiceOptions) DeepCopy() *SQLServiceOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleStatus) DeepCopyInto(out *ScheduleStatus) {
+	*out = *in
+	if in.LastTriggerTime != nil {
+		in, out := &in.LastTriggerTime, &out.LastTriggerTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextTriggerTime != nil {
+		in, out := &in.NextTriggerTime, &out.NextTriggerTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleStatus.
+func (in *ScheduleStatus) DeepCopy() *ScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuritySpec) DeepCopyInto(out *SecuritySpec) {
+	*out = *in
+	if in.OIDC != nil {
+		in, out := &in.OIDC, &out.OIDC
+		*out = new(OIDCSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuritySpec.
+func (in *SecuritySpec) DeepCopy() *SecuritySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuritySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceSpec) DeepCopyInto(out *ServiceSpec) {
 	*out = *in
@@ -881,6 +1873,16 @@ func (in *ServiceSpec) DeepCopyInto(out *ServiceSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.PodTemplate.DeepCopyInto(&out.PodTemplate)
+	if in.Exposure != nil {
+		in, out := &in.Exposure, &out.Exposure
+		*out = new(ExposureSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPRoute != nil {
+		in, out := &in.HTTPRoute, &out.HTTPRoute
+		*out = new(HTTPRouteSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceSpec.
@@ -1008,6 +2010,11 @@ func (in *SonataFlowBuildList) DeepCopyObject() runtime.Object {
 func (in *SonataFlowBuildSpec) DeepCopyInto(out *SonataFlowBuildSpec) {
 	*out = *in
 	in.BuildTemplate.DeepCopyInto(&out.BuildTemplate)
+	if in.Git != nil {
+		in, out := &in.Git, &out.Git
+		*out = new(GitSource)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonataFlowBuildSpec.
@@ -1023,6 +2030,18 @@ func (in *SonataFlowBuildSpec) DeepCopy() *SonataFlowBuildSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SonataFlowBuildStatus) DeepCopyInto(out *SonataFlowBuildStatus) {
 	*out = *in
+	if in.NextRetryAt != nil {
+		in, out := &in.NextRetryAt, &out.NextRetryAt
+		*out = (*in).DeepCopy()
+	}
+	if in.QueuedAt != nil {
+		in, out := &in.QueuedAt, &out.QueuedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
 	in.InnerBuild.DeepCopyInto(&out.InnerBuild)
 }
 
@@ -1283,7 +2302,7 @@ func (in *SonataFlowPlatformRef) DeepCopy() *SonataFlowPlatformRef {
 func (in *SonataFlowPlatformSpec) DeepCopyInto(out *SonataFlowPlatformSpec) {
 	*out = *in
 	in.Build.DeepCopyInto(&out.Build)
-	out.DevMode = in.DevMode
+	in.DevMode.DeepCopyInto(&out.DevMode)
 	if in.Services != nil {
 		in, out := &in.Services, &out.Services
 		*out = new(ServicesPlatformSpec)
@@ -1309,6 +2328,31 @@ func (in *SonataFlowPlatformSpec) DeepCopyInto(out *SonataFlowPlatformSpec) {
 		*out = new(PlatformMonitoringOptionsSpec)
 		**out = **in
 	}
+	if in.NetworkPolicies != nil {
+		in, out := &in.NetworkPolicies, &out.NetworkPolicies
+		*out = new(PlatformNetworkPoliciesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthProbes != nil {
+		in, out := &in.HealthProbes, &out.HealthProbes
+		*out = new(HealthProbesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(SecuritySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(PlatformTLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Recovery != nil {
+		in, out := &in.Recovery, &out.Recovery
+		*out = new(RecoveryPolicySpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonataFlowPlatformSpec.
@@ -1342,6 +2386,11 @@ func (in *SonataFlowPlatformStatus) DeepCopyInto(out *SonataFlowPlatformStatus)
 		*out = make([]SonataFlowPlatformTriggerRef, len(*in))
 		copy(*out, *in)
 	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = new(PlatformServicesStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonataFlowPlatformStatus.
@@ -1369,6 +2418,22 @@ func (in *SonataFlowPlatformTriggerRef) DeepCopy() *SonataFlowPlatformTriggerRef
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SonataFlowSinkSpec) DeepCopyInto(out *SonataFlowSinkSpec) {
+	*out = *in
+	in.Destination.DeepCopyInto(&out.Destination)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonataFlowSinkSpec.
+func (in *SonataFlowSinkSpec) DeepCopy() *SonataFlowSinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SonataFlowSinkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SonataFlowSourceSpec) DeepCopyInto(out *SonataFlowSourceSpec) {
 	*out = *in
@@ -1401,6 +2466,13 @@ func (in *SonataFlowSpec) DeepCopyInto(out *SonataFlowSpec) {
 		*out = new(duckv1.Destination)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]SonataFlowSinkSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Sources != nil {
 		in, out := &in.Sources, &out.Sources
 		*out = make([]SonataFlowSourceSpec, len(*in))
@@ -1408,6 +2480,26 @@ func (in *SonataFlowSpec) DeepCopyInto(out *SonataFlowSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Git != nil {
+		in, out := &in.Git, &out.Git
+		*out = new(GitSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Exposure != nil {
+		in, out := &in.Exposure, &out.Exposure
+		*out = new(ExposureSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPRoute != nil {
+		in, out := &in.HTTPRoute, &out.HTTPRoute
+		*out = new(HTTPRouteSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(SecuritySpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonataFlowSpec.
@@ -1446,6 +2538,31 @@ func (in *SonataFlowStatus) DeepCopyInto(out *SonataFlowStatus) {
 		*out = make([]SonataFlowTriggerRef, len(*in))
 		copy(*out, *in)
 	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(KnativeCanaryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlueGreen != nil {
+		in, out := &in.BlueGreen, &out.BlueGreen
+		*out = new(BlueGreenStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(ScheduleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(JobStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DevMode != nil {
+		in, out := &in.DevMode, &out.DevMode
+		*out = new(DevModeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SonataFlowStatus.
@@ -1473,6 +2590,31 @@ func (in *SonataFlowTriggerRef) DeepCopy() *SonataFlowTriggerRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologySpreadSpec) DeepCopyInto(out *TopologySpreadSpec) {
+	*out = *in
+	if in.TopologyKeys != nil {
+		in, out := &in.TopologyKeys, &out.TopologyKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxSkew != nil {
+		in, out := &in.MaxSkew, &out.MaxSkew
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologySpreadSpec.
+func (in *TopologySpreadSpec) DeepCopy() *TopologySpreadSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologySpreadSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowResources) DeepCopyInto(out *WorkflowResources) {
 	*out = *in
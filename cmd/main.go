@@ -25,15 +25,18 @@ import (
 	"os"
 
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/certmanager"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/cfg"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/version"
 	prometheus "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"k8s.io/klog/v2/klogr"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	eventingv1beta2 "knative.dev/eventing/pkg/apis/eventing/v1beta2"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1"
 
 	"k8s.io/klog/v2"
 
@@ -65,8 +68,10 @@ func init() {
 	utilruntime.Must(operatorapi.AddToScheme(scheme))
 	utilruntime.Must(sourcesv1.AddToScheme(scheme))
 	utilruntime.Must(eventingv1.AddToScheme(scheme))
+	utilruntime.Must(eventingv1beta2.AddToScheme(scheme))
 	utilruntime.Must(servingv1.AddToScheme(scheme))
 	utilruntime.Must(prometheus.AddToScheme(scheme))
+	utilruntime.Must(gwapi.Install(scheme))
 	//+kubebuilder:scaffold:scheme
 }
 
@@ -143,6 +148,10 @@ func main() {
 	// Set global assessors
 	utils.SetIsOpenShift(mgr.GetConfig())
 	utils.SetClient(mgr.GetClient())
+	if err = certmanager.SetAvailable(mgr.GetConfig()); err != nil {
+		klog.V(log.E).ErrorS(err, "unable to discover cert-manager")
+		os.Exit(1)
+	}
 
 	// Fail fast, we can change this behavior in the future to read from defaults instead.
 	if _, err = cfg.InitializeControllersCfgAt(controllerCfgPath); err != nil {
@@ -60,6 +60,22 @@ type ContainerBuildSpec struct {
 	// and its phase set to ContainerBuildPhaseFailed.
 	// +kubebuilder:validation:Format=duration
 	Timeout metav1.Duration `json:"timeout,omitempty"`
+	// RetryPolicy defines how a failed ContainerBuild is retried. If empty, the default policy is applied.
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// RetryPolicy defines how many times and when a failed ContainerBuild is retried
+type RetryPolicy struct {
+	// maximum number of retries, zero disables the retries
+	MaxAttempts int `json:"maxAttempts"`
+	// time to wait before the first retry
+	MinBackoff metav1.Duration `json:"minBackoff,omitempty"`
+	// maximum time to wait between retries
+	MaxBackoff metav1.Duration `json:"maxBackoff,omitempty"`
+	// factor applied to the time to wait after each retry
+	Factor float64 `json:"factor,omitempty"`
+	// regular expressions matching the retryable failure reasons, every failure is retryable if empty
+	RetryableReasons []string `json:"retryableReasons,omitempty"`
 }
 
 // ContainerRegistrySpec provides the configuration for the container registry
@@ -92,6 +108,28 @@ type ContainerBuildBaseTask struct {
 	BuildArgs []corev1.EnvVar
 	// Environment variable passed to the internal build container.
 	Envs []corev1.EnvVar `json:"envs,omitempty"`
+	// Additional volumes available to the internal build container
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+	// Where to mount the additional volumes in the internal build container
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	// Scheduling configuration for the internal build pod
+	PodTemplate *PodTemplate `json:"podTemplate,omitempty"`
+}
+
+// PodTemplate describes how the build pods are scheduled in the cluster
+type PodTemplate struct {
+	// selector which must be true for the pod to fit on a node
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// the pod tolerations
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// the pod scheduling constraints
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// the pod priority class
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// the ServiceAccount used to run the pod
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+	// the secrets used to pull the pod images
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
 }
 
 // PublishTask image publish configuration
@@ -127,6 +165,24 @@ type KanikoTask struct {
 	AdditionalFlags []string `json:"additionalFlags,omitempty"`
 	// Image used by the created Kaniko pod executor
 	KanikoExecutorImage string `json:"kanikoExecutorImage,omitempty"`
+	// Git repository to clone and use as the build context instead of the mounted resources
+	Git *GitSource `json:"git,omitempty"`
+}
+
+// GitSource describes a Git repository used as the build context
+type GitSource struct {
+	// URL of the repository to clone
+	URL string `json:"url"`
+	// Revision branch, tag, or commit to check out, the default branch if empty
+	Revision string `json:"revision,omitempty"`
+	// ContextDir sub-directory within the repository used as the build context
+	ContextDir string `json:"contextDir,omitempty"`
+	// Secret name holding the basic-auth or ssh-auth credentials to clone the repository
+	Secret string `json:"secret,omitempty"`
+	// CloneImage image used by the init container that clones the repository
+	CloneImage string `json:"cloneImage,omitempty"`
+	// InsecureSkipHostKeyVerification clones through SSH without verifying the host key when the Secret has no known_hosts key
+	InsecureSkipHostKeyVerification bool `json:"insecureSkipHostKeyVerification,omitempty"`
 }
 
 // KanikoTaskCache is used to configure Kaniko cache
@@ -192,6 +248,8 @@ type ContainerBuildStatus struct {
 	Digest string `json:"digest,omitempty"`
 	// the base image used for this build
 	BaseImage string `json:"baseImage,omitempty"`
+	// the commit resolved from the Git source (if any)
+	GitCommit string `json:"gitCommit,omitempty"`
 	// the error description (if any)
 	Error string `json:"error,omitempty"`
 	// the reason of the failure (if any)
@@ -76,6 +76,31 @@ func newBuildPod(ctx context.Context, c client.Client, build *api.ContainerBuild
 	return pod, nil
 }
 
+// applyPodTemplate sets the scheduling configuration from the given template, if any, to the build pod.
+func applyPodTemplate(template *api.PodTemplate, pod *corev1.Pod) {
+	if template == nil {
+		return
+	}
+	if len(template.NodeSelector) > 0 {
+		pod.Spec.NodeSelector = template.NodeSelector
+	}
+	if len(template.Tolerations) > 0 {
+		pod.Spec.Tolerations = template.Tolerations
+	}
+	if template.Affinity != nil {
+		pod.Spec.Affinity = template.Affinity
+	}
+	if len(template.PriorityClassName) > 0 {
+		pod.Spec.PriorityClassName = template.PriorityClassName
+	}
+	if len(template.ServiceAccountName) > 0 {
+		pod.Spec.ServiceAccountName = template.ServiceAccountName
+	}
+	if len(template.ImagePullSecrets) > 0 {
+		pod.Spec.ImagePullSecrets = template.ImagePullSecrets
+	}
+}
+
 func buildPodName(build *api.ContainerBuild) string {
 	return "sonataflow-" + strings.ToLower(build.Name) + "-builder"
 }
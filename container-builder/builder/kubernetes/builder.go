@@ -42,6 +42,10 @@ type ContainerBuilderInfo struct {
 	Platform        api.PlatformContainerBuild
 	// ContainerBuilderImageTag the image tag used internally to create the pod builder (e.g. Kaniko Executor Builder image)
 	ContainerBuilderImageTag string
+	// GitSource when set, the build context is cloned from the given Git repository
+	GitSource *api.GitSource
+	// RetryPolicy defines how the build is retried on failures, the DefaultRetryPolicy if empty
+	RetryPolicy *api.RetryPolicy
 }
 
 type resource struct {
@@ -94,6 +98,10 @@ type Scheduler interface {
 	WithProperty(property BuilderProperty, object interface{}) Scheduler
 	WithBuildArgs(args []corev1.EnvVar) Scheduler
 	WithEnvs(envs []corev1.EnvVar) Scheduler
+	// WithVolumes additional volumes to mount in the underlying builder. For example, a cache or configuration files required by the build.
+	WithVolumes(volumes []corev1.Volume, mounts []corev1.VolumeMount) Scheduler
+	// WithPodTemplate scheduling configuration for the underlying builder pod, if any. For example, node selector and tolerations.
+	WithPodTemplate(template *api.PodTemplate) Scheduler
 	Schedule() (*api.ContainerBuild, error)
 }
 
@@ -52,13 +52,15 @@ func (k kanikoSchedulerManager) CreateScheduler(info ContainerBuilderInfo, ctx *
 		},
 		Cache:               api.KanikoTaskCache{},
 		KanikoExecutorImage: info.ContainerBuilderImageTag,
+		Git:                 info.GitSource,
 	}
 
 	ctx.containerBuild = &api.ContainerBuild{
 		Spec: api.ContainerBuildSpec{
-			Tasks:    []api.ContainerBuildTask{{Kaniko: &kanikoTask}},
-			Strategy: api.ContainerBuildStrategyPod,
-			Timeout:  *info.Platform.Spec.Timeout,
+			Tasks:       []api.ContainerBuildTask{{Kaniko: &kanikoTask}},
+			Strategy:    api.ContainerBuildStrategyPod,
+			Timeout:     *info.Platform.Spec.Timeout,
+			RetryPolicy: info.RetryPolicy,
 		},
 		Status: api.ContainerBuildStatus{},
 	}
@@ -103,6 +105,17 @@ func (sk *kanikoScheduler) WithEnvs(envs []corev1.EnvVar) Scheduler {
 	return sk
 }
 
+func (sk *kanikoScheduler) WithVolumes(volumes []corev1.Volume, mounts []corev1.VolumeMount) Scheduler {
+	sk.kanikoTask.Volumes = volumes
+	sk.kanikoTask.VolumeMounts = mounts
+	return sk
+}
+
+func (sk *kanikoScheduler) WithPodTemplate(template *api.PodTemplate) Scheduler {
+	sk.kanikoTask.PodTemplate = template
+	return sk
+}
+
 func (sk *kanikoScheduler) Schedule() (*api.ContainerBuild, error) {
 	return sk.schedulerHook()
 }
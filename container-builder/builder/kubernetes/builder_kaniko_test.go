@@ -150,6 +150,16 @@ func TestNewBuildWithKanikoWithBuildArgsAndEnv(t *testing.T) {
 			Name:  "MYENV",
 			Value: "value",
 		}}).
+		WithVolumes([]v1.Volume{{
+			Name:         "maven-repository",
+			VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "maven-repo"}},
+		}}, []v1.VolumeMount{{Name: "maven-repository", MountPath: "/maven/repository"}}).
+		WithPodTemplate(&api.PodTemplate{
+			NodeSelector:      map[string]string{"node-role.kubernetes.io/build": ""},
+			Tolerations:       []v1.Toleration{{Key: "build", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule}},
+			PriorityClassName: "low-priority",
+			ImagePullSecrets:  []v1.LocalObjectReference{{Name: "registry-pull"}},
+		}).
 		Schedule()
 
 	// reconcile twice to push forward to the pod creation
@@ -169,4 +179,87 @@ func TestNewBuildWithKanikoWithBuildArgsAndEnv(t *testing.T) {
 	assert.Subset(t, pod.Spec.Containers[0].Args, []string{"--build-arg=QUARKUS_EXTENSIONS=extension1,extension2"})
 	assert.Subset(t, pod.Spec.Containers[0].Args, []string{"--build-arg=MY_PROPERTY=my_property_value"})
 	assert.Subset(t, pod.Spec.Containers[0].Env, []v1.EnvVar{{Name: "MYENV", Value: "value"}})
+	assert.Contains(t, pod.Spec.Containers[0].VolumeMounts, v1.VolumeMount{Name: "maven-repository", MountPath: "/maven/repository"})
+	assert.Equal(t, "maven-repo", pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, map[string]string{"node-role.kubernetes.io/build": ""}, pod.Spec.NodeSelector)
+	assert.Len(t, pod.Spec.Tolerations, 1)
+	assert.Equal(t, "low-priority", pod.Spec.PriorityClassName)
+	assert.Equal(t, []v1.LocalObjectReference{{Name: "registry-pull"}}, pod.Spec.ImagePullSecrets)
+	assert.Empty(t, pod.Spec.ServiceAccountName)
+}
+
+func TestNewBuildWithKanikoFromGitSource(t *testing.T) {
+	ns := "test"
+	c := test.NewFakeClient()
+
+	dockerFile, err := os.ReadFile("testdata/Dockerfile")
+	assert.NoError(t, err)
+
+	platform := api.PlatformContainerBuild{
+		ObjectReference: api.ObjectReference{
+			Namespace: ns,
+			Name:      "testPlatform",
+		},
+		Spec: api.PlatformContainerBuildSpec{
+			BuildStrategy:   api.ContainerBuildStrategyPod,
+			PublishStrategy: api.PlatformBuildPublishStrategyKaniko,
+			Timeout:         &metav1.Duration{Duration: 5 * time.Minute},
+		},
+	}
+	gitSource := &api.GitSource{
+		URL:        "https://github.com/apache/incubator-kie-kogito-examples.git",
+		Revision:   "main",
+		ContextDir: "serverless-workflow-examples/serverless-workflow-greeting-quarkus",
+		Secret:     "git-credentials",
+	}
+
+	build, err := NewBuild(ContainerBuilderInfo{FinalImageName: "docker.io/apache/incubator-kie-buildexample:latest", BuildUniqueName: "build1", Platform: platform, GitSource: gitSource}).
+		AddResource("Dockerfile", dockerFile).
+		WithClient(c).
+		Scheduler().
+		Schedule()
+	assert.NoError(t, err)
+
+	// reconcile twice to push forward to the pod creation
+	build, err = FromBuild(build).WithClient(c).Reconcile()
+	assert.NoError(t, err)
+	build, err = FromBuild(build).WithClient(c).Reconcile()
+	assert.NoError(t, err)
+
+	pod := &v1.Pod{}
+	err = c.Get(context.TODO(), types.NamespacedName{Name: buildPodName(build), Namespace: ns}, pod)
+	assert.NoError(t, err)
+
+	assert.Len(t, pod.Spec.InitContainers, 1)
+	assert.Equal(t, DefaultGitCloneImage, pod.Spec.InitContainers[0].Image)
+	assert.Subset(t, pod.Spec.InitContainers[0].Env, []v1.EnvVar{
+		{Name: "GIT_URL", Value: gitSource.URL},
+		{Name: "GIT_REVISION", Value: gitSource.Revision},
+	})
+	assert.Len(t, pod.Spec.Volumes, 3)
+	assert.Subset(t, pod.Spec.Containers[0].Args, []string{
+		"--dockerfile=/builder/build1/context/Dockerfile",
+		"--context=dir:///workspace/source/serverless-workflow-examples/serverless-workflow-greeting-quarkus",
+	})
+	assert.Contains(t, pod.Spec.Containers[0].VolumeMounts, v1.VolumeMount{Name: gitSourceVolumeName, MountPath: gitWorkspacePath})
+
+	pod.Status.InitContainerStatuses = []v1.ContainerStatus{{
+		Name:  gitCloneContainerName,
+		State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 0, Message: "0a1b2c3d\n"}},
+	}}
+	assert.Equal(t, "0a1b2c3d", getGitCommit(pod))
+}
+
+func TestAddGitCloneToPodHostKeyVerification(t *testing.T) {
+	pod := &v1.Pod{}
+	addGitCloneToPod(&api.GitSource{URL: "git@github.com:my-org/my-workflow.git", Secret: "git-ssh"}, pod)
+	assert.Len(t, pod.Spec.InitContainers, 1)
+	assert.Contains(t, pod.Spec.InitContainers[0].Args[0], "-o UserKnownHostsFile=/etc/git-secret/known_hosts -o StrictHostKeyChecking=yes")
+	for _, env := range pod.Spec.InitContainers[0].Env {
+		assert.NotEqual(t, "GIT_SSH_INSECURE_SKIP_HOST_KEY_VERIFICATION", env.Name)
+	}
+
+	pod = &v1.Pod{}
+	addGitCloneToPod(&api.GitSource{URL: "git@github.com:my-org/my-workflow.git", Secret: "git-ssh", InsecureSkipHostKeyVerification: true}, pod)
+	assert.Contains(t, pod.Spec.InitContainers[0].Env, v1.EnvVar{Name: "GIT_SSH_INSECURE_SKIP_HOST_KEY_VERIFICATION", Value: "true"})
 }
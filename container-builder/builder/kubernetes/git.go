@@ -0,0 +1,122 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/apache/incubator-kie-kogito-serverless-operator/container-builder/api"
+)
+
+const (
+	gitCloneContainerName = "git-clone"
+	gitSourceVolumeName   = "git-source"
+	gitSecretVolumeName   = "git-secret"
+	gitWorkspacePath      = "/workspace"
+	gitSecretPath         = "/etc/git-secret"
+	gitKnownHostsKey      = "known_hosts"
+	// DefaultGitCloneImage image used to clone the Git sources when none is given
+	DefaultGitCloneImage = "docker.io/alpine/git:2.45.2"
+)
+
+// gitCloneScript clones the repository, checks out the requested revision and writes the resolved commit to the
+// termination log, so it can be read back once the build finishes.
+// Credentials are taken from the mounted basic-auth (username/password) or ssh-auth (ssh-privatekey) Secret keys.
+// The SSH host key is verified against the known_hosts Secret key, the check is only skipped when explicitly requested.
+const gitCloneScript = `set -e
+export HOME=/tmp
+if [ -f ` + gitSecretPath + `/ssh-privatekey ]; then
+  cp ` + gitSecretPath + `/ssh-privatekey /tmp/ssh-privatekey && chmod 600 /tmp/ssh-privatekey
+  if [ -f ` + gitSecretPath + `/` + gitKnownHostsKey + ` ]; then
+    export GIT_SSH_COMMAND="ssh -i /tmp/ssh-privatekey -o UserKnownHostsFile=` + gitSecretPath + `/` + gitKnownHostsKey + ` -o StrictHostKeyChecking=yes"
+  elif [ "$GIT_SSH_INSECURE_SKIP_HOST_KEY_VERIFICATION" = "true" ]; then
+    export GIT_SSH_COMMAND="ssh -i /tmp/ssh-privatekey -o StrictHostKeyChecking=no"
+  else
+    echo "the Git secret must hold the ` + gitKnownHostsKey + ` key to verify the SSH host" >&2
+    exit 1
+  fi
+fi
+if [ -f ` + gitSecretPath + `/username ]; then
+  git config --global credential.helper '!f() { echo "username=$(cat ` + gitSecretPath + `/username)"; echo "password=$(cat ` + gitSecretPath + `/password)"; }; f'
+fi
+git clone "$GIT_URL" ` + gitWorkspacePath + `/source
+cd ` + gitWorkspacePath + `/source
+if [ -n "$GIT_REVISION" ]; then git checkout "$GIT_REVISION"; fi
+git rev-parse HEAD > /dev/termination-log
+`
+
+// gitSourceContextDir returns the directory where the Git build context is available to the builder container.
+func gitSourceContextDir(source *api.GitSource) string {
+	return path.Join(gitWorkspacePath, "source", source.ContextDir)
+}
+
+// addGitCloneToPod adds an init container that clones the Git source to a volume shared with the builder container.
+// Returns the volume mount the builder container must use to access the sources.
+func addGitCloneToPod(source *api.GitSource, pod *corev1.Pod) corev1.VolumeMount {
+	workspaceMount := corev1.VolumeMount{Name: gitSourceVolumeName, MountPath: gitWorkspacePath}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name:         gitSourceVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+	volumeMounts := []corev1.VolumeMount{workspaceMount}
+	if source.Secret != "" {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name:         gitSecretVolumeName,
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: source.Secret}},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{Name: gitSecretVolumeName, MountPath: gitSecretPath, ReadOnly: true})
+	}
+
+	image := source.CloneImage
+	if image == "" {
+		image = DefaultGitCloneImage
+	}
+	env := []corev1.EnvVar{
+		{Name: "GIT_URL", Value: source.URL},
+		{Name: "GIT_REVISION", Value: source.Revision},
+	}
+	if source.InsecureSkipHostKeyVerification {
+		env = append(env, corev1.EnvVar{Name: "GIT_SSH_INSECURE_SKIP_HOST_KEY_VERIFICATION", Value: "true"})
+	}
+	env = append(env, proxyFromEnvironment()...)
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, corev1.Container{
+		Name:            gitCloneContainerName,
+		Image:           image,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command:         []string{"/bin/sh", "-c"},
+		Args:            []string{gitCloneScript},
+		Env:             env,
+		VolumeMounts:    volumeMounts,
+	})
+	return workspaceMount
+}
+
+// getGitCommit reads the commit resolved by the clone init container, if any.
+func getGitCommit(pod *corev1.Pod) string {
+	for _, container := range pod.Status.InitContainerStatuses {
+		if container.Name == gitCloneContainerName && container.State.Terminated != nil && container.State.Terminated.ExitCode == 0 {
+			return strings.TrimSpace(container.State.Terminated.Message)
+		}
+	}
+	return ""
+}
@@ -22,6 +22,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"path"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -82,9 +83,16 @@ func addKanikoTaskToPod(ctx context.Context, c client.Client, build *api.Contain
 
 	// TODO: verify how cache is possible
 	// TODO: the PlatformContainerBuild structure should be able to identify the Kaniko context. For simplicity, let's use a CM with `dir://`
+	dockerfile := "Dockerfile"
+	buildContextDir := task.ContextDir
+	if task.Git != nil {
+		// the Dockerfile stays in the mounted resources while the context comes from the cloned repository
+		dockerfile = path.Join(task.ContextDir, "Dockerfile")
+		buildContextDir = gitSourceContextDir(task.Git)
+	}
 	args := []string{
-		"--dockerfile=Dockerfile",
-		"--context=dir://" + task.ContextDir,
+		"--dockerfile=" + dockerfile,
+		"--context=dir://" + buildContextDir,
 		"--destination=" + task.GetRepositoryImageTag(),
 		"--ignore-path=/product_uuid",
 	}
@@ -102,6 +110,8 @@ func addKanikoTaskToPod(ctx context.Context, c client.Client, build *api.Contain
 	env = append(env, task.Envs...)
 	volumes := make([]corev1.Volume, 0)
 	volumeMounts := make([]corev1.VolumeMount, 0)
+	volumes = append(volumes, task.Volumes...)
+	volumeMounts = append(volumeMounts, task.VolumeMounts...)
 
 	if task.Registry.Secret != "" {
 		secret, err := getRegistrySecret(ctx, c, pod.Namespace, task.Registry.Secret, kanikoRegistrySecrets)
@@ -121,6 +131,10 @@ func addKanikoTaskToPod(ctx context.Context, c client.Client, build *api.Contain
 		return err
 	}
 
+	if task.Git != nil {
+		volumeMounts = append(volumeMounts, addGitCloneToPod(task.Git, pod))
+	}
+
 	env = append(env, proxyFromEnvironment()...)
 
 	buildArgs, err := FromEnvToArgs(c, pod.Namespace, task.BuildArgs...)
@@ -147,6 +161,7 @@ func addKanikoTaskToPod(ctx context.Context, c client.Client, build *api.Contain
 
 	// We may want to handle possible conflicts
 	pod.Spec.Affinity = affinity
+	applyPodTemplate(task.PodTemplate, pod)
 	pod.Spec.Volumes = append(pod.Spec.Volumes, volumes...)
 	pod.Spec.Containers = append(pod.Spec.Containers, container)
 
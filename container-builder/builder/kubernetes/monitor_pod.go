@@ -111,6 +111,9 @@ func (action *monitorPodAction) Handle(ctx context.Context, build *api.Container
 		for _, task := range build.Spec.Tasks {
 			if t := task.Kaniko; t != nil {
 				build.Status.RepositoryImageTag = t.GetRepositoryImageTag()
+				if t.Git != nil {
+					build.Status.GitCommit = getGitCommit(pod)
+				}
 				break
 			}
 		}
@@ -21,6 +21,7 @@ package kubernetes
 
 import (
 	"context"
+	"regexp"
 	"time"
 
 	"k8s.io/klog/v2"
@@ -34,21 +35,52 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// DefaultRetryPolicy policy applied to the builds without a RetryPolicy
+var DefaultRetryPolicy = api.RetryPolicy{
+	MaxAttempts: 5,
+	MinBackoff:  metav1.Duration{Duration: 5 * time.Second},
+	MaxBackoff:  metav1.Duration{Duration: 1 * time.Minute},
+	Factor:      2,
+}
+
 func newErrorRecoveryAction() Action {
-	// TODO: externalize options
-	return &errorRecoveryAction{
-		backOff: backoff.Backoff{
-			Min:    5 * time.Second,
-			Max:    1 * time.Minute,
-			Factor: 2,
-			Jitter: false,
-		},
+	return &errorRecoveryAction{}
+}
+
+// RetryBackoff returns how long to wait before the given retry attempt according to the given policy.
+func RetryBackoff(policy *api.RetryPolicy, attempt int) time.Duration {
+	if policy == nil {
+		policy = &DefaultRetryPolicy
+	}
+	b := backoff.Backoff{
+		Min:    policy.MinBackoff.Duration,
+		Max:    policy.MaxBackoff.Duration,
+		Factor: policy.Factor,
+		Jitter: false,
+	}
+	return b.ForAttempt(float64(attempt))
+}
+
+// IsRetryableFailure verifies if the given failure reason matches any of the policy retryable reasons.
+func IsRetryableFailure(policy *api.RetryPolicy, reason string) bool {
+	if policy == nil || len(policy.RetryableReasons) == 0 {
+		return true
 	}
+	for _, retryable := range policy.RetryableReasons {
+		re, err := regexp.Compile(retryable)
+		if err != nil {
+			klog.V(log.E).ErrorS(err, "Invalid retryable failure reason expression", "expression", retryable)
+			continue
+		}
+		if re.MatchString(reason) {
+			return true
+		}
+	}
+	return false
 }
 
 type errorRecoveryAction struct {
 	baseAction
-	backOff backoff.Backoff
 }
 
 func (action *errorRecoveryAction) Name() string {
@@ -60,18 +92,28 @@ func (action *errorRecoveryAction) CanHandle(build *api.ContainerBuild) bool {
 }
 
 func (action *errorRecoveryAction) Handle(ctx context.Context, build *api.ContainerBuild) (*api.ContainerBuild, error) {
+	policy := build.Spec.RetryPolicy
+	if policy == nil {
+		policy = &DefaultRetryPolicy
+	}
 	if build.Status.Failure == nil {
 		build.Status.Failure = &api.ContainerBuildFailure{
 			Reason: build.Status.Error,
 			Time:   metav1.Now(),
 			Recovery: api.ContainerBuildFailureRecovery{
 				Attempt:    0,
-				AttemptMax: 5,
+				AttemptMax: policy.MaxAttempts,
 			},
 		}
 		return build, nil
 	}
 
+	if !IsRetryableFailure(policy, build.Status.Error) {
+		klog.V(log.I).InfoS("Build failure is not retryable", "reason", build.Status.Error)
+		build.Status.Phase = api.ContainerBuildPhaseError
+		return build, nil
+	}
+
 	if build.Status.Failure.Recovery.Attempt >= build.Status.Failure.Recovery.AttemptMax {
 		build.Status.Phase = api.ContainerBuildPhaseError
 		return build, nil
@@ -83,7 +125,7 @@ func (action *errorRecoveryAction) Handle(ctx context.Context, build *api.Contai
 	}
 
 	elapsed := time.Since(lastAttempt).Seconds()
-	elapsedMin := action.backOff.ForAttempt(float64(build.Status.Failure.Recovery.Attempt)).Seconds()
+	elapsedMin := RetryBackoff(policy, build.Status.Failure.Recovery.Attempt).Seconds()
 
 	if elapsed < elapsedMin {
 		return nil, nil
@@ -0,0 +1,84 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/apache/incubator-kie-kogito-serverless-operator/container-builder/api"
+)
+
+func newFailedBuild(policy *api.RetryPolicy, reason string) *api.ContainerBuild {
+	return &api.ContainerBuild{
+		Spec:   api.ContainerBuildSpec{RetryPolicy: policy},
+		Status: api.ContainerBuildStatus{Phase: api.ContainerBuildPhaseFailed, Error: reason},
+	}
+}
+
+func TestErrorRecoveryAction_RetryWithPolicy(t *testing.T) {
+	policy := &api.RetryPolicy{
+		MaxAttempts:      1,
+		MinBackoff:       metav1.Duration{Duration: time.Millisecond},
+		MaxBackoff:       metav1.Duration{Duration: time.Millisecond},
+		Factor:           2,
+		RetryableReasons: []string{"(?i)timeout", "connection reset"},
+	}
+	action := newErrorRecoveryAction()
+	build := newFailedBuild(policy, "ContainerBuild timeout")
+
+	build, err := action.Handle(context.TODO(), build)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, build.Status.Failure.Recovery.AttemptMax)
+
+	build.Status.Failure.Time = metav1.NewTime(time.Now().Add(-time.Second))
+	build, err = action.Handle(context.TODO(), build)
+	assert.NoError(t, err)
+	assert.Equal(t, api.ContainerBuildPhaseInitialization, build.Status.Phase)
+	assert.Equal(t, 1, build.Status.Failure.Recovery.Attempt)
+
+	// attempts exhausted
+	build.Status.Phase = api.ContainerBuildPhaseFailed
+	build, err = action.Handle(context.TODO(), build)
+	assert.NoError(t, err)
+	assert.Equal(t, api.ContainerBuildPhaseError, build.Status.Phase)
+}
+
+func TestErrorRecoveryAction_NonRetryableFailure(t *testing.T) {
+	action := newErrorRecoveryAction()
+	build := newFailedBuild(&api.RetryPolicy{MaxAttempts: 3, RetryableReasons: []string{"timeout"}}, "Pod failed")
+
+	build, err := action.Handle(context.TODO(), build)
+	assert.NoError(t, err)
+	build, err = action.Handle(context.TODO(), build)
+	assert.NoError(t, err)
+	assert.Equal(t, api.ContainerBuildPhaseError, build.Status.Phase)
+	assert.Equal(t, 0, build.Status.Failure.Recovery.Attempt)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	assert.Equal(t, 5*time.Second, RetryBackoff(nil, 0))
+	assert.Equal(t, 20*time.Second, RetryBackoff(nil, 2))
+	assert.Equal(t, time.Minute, RetryBackoff(nil, 10))
+}
@@ -0,0 +1,219 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package builder
+
+import (
+	"context"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/clusterplatform"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/platform"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/log"
+)
+
+// BuildQueue holds new SonataFlowBuild instances in the BuildPhaseQueued phase while the platform has reached
+// the maximum number of concurrent builds.
+type BuildQueue interface {
+	// Admit verifies if the given build can be scheduled right away.
+	// If not, the build status is moved to BuildPhaseQueued with its current position in the queue.
+	Admit(build *operatorapi.SonataFlowBuild) (bool, error)
+}
+
+var _ BuildQueue = &buildQueue{}
+
+type buildQueue struct {
+	ctx    context.Context
+	client client.Client
+}
+
+// NewBuildQueue creates a BuildQueue backed by the SonataFlowBuild instances in the cluster.
+func NewBuildQueue(ctx context.Context, client client.Client) BuildQueue {
+	return &buildQueue{ctx: ctx, client: client}
+}
+
+func (q *buildQueue) Admit(build *operatorapi.SonataFlowBuild) (bool, error) {
+	plat, err := platform.GetActivePlatform(q.ctx, q.client, build.Namespace)
+	if err != nil {
+		return false, err
+	}
+	clusterLimit, err := q.getClusterLimit()
+	if err != nil {
+		return false, err
+	}
+	namespaceLimit := plat.Spec.Build.Config.GetMaxConcurrentBuilds()
+	if namespaceLimit <= 0 && clusterLimit <= 0 {
+		dequeue(build)
+		return true, nil
+	}
+
+	builds := &operatorapi.SonataFlowBuildList{}
+	var opts []client.ListOption
+	if clusterLimit <= 0 {
+		opts = append(opts, client.InNamespace(build.Namespace))
+	}
+	if err = q.client.List(q.ctx, builds, opts...); err != nil {
+		return false, err
+	}
+
+	if build.Status.QueuedAt == nil {
+		now := metav1.Now()
+		build.Status.QueuedAt = &now
+	}
+	admitted := true
+	var position int32
+	if namespaceLimit > 0 {
+		ok, pos := admitWithin(build, builds.Items, namespaceLimit, func(b *operatorapi.SonataFlowBuild) bool {
+			return b.Namespace == build.Namespace
+		})
+		admitted = admitted && ok
+		position = max(position, pos)
+	}
+	if clusterLimit > 0 {
+		ok, pos := admitWithin(build, builds.Items, clusterLimit, func(b *operatorapi.SonataFlowBuild) bool {
+			return true
+		})
+		admitted = admitted && ok
+		position = max(position, pos)
+	}
+
+	if admitted {
+		dequeue(build)
+		return true, nil
+	}
+	build.Status.BuildPhase = operatorapi.BuildPhaseQueued
+	build.Status.QueuePosition = position
+	klog.V(log.D).InfoS("Build queued, waiting for a free build slot", "build", build.Name, "namespace", build.Namespace, "position", position)
+	return false, nil
+}
+
+// getClusterLimit returns the cluster-wide limit defined in the platform referenced by the active SonataFlowClusterPlatform.
+func (q *buildQueue) getClusterLimit() (int32, error) {
+	clusterPlatforms := operatorapi.NewSonataFlowClusterPlatformList()
+	if err := q.client.List(q.ctx, &clusterPlatforms); err != nil {
+		return 0, err
+	}
+	for i := range clusterPlatforms.Items {
+		cPlatform := &clusterPlatforms.Items[i]
+		if clusterplatform.IsSecondary(cPlatform) || !clusterplatform.IsActive(cPlatform) {
+			continue
+		}
+		refPlatform := &operatorapi.SonataFlowPlatform{}
+		key := types.NamespacedName{Namespace: cPlatform.Spec.PlatformRef.Namespace, Name: cPlatform.Spec.PlatformRef.Name}
+		if err := q.client.Get(q.ctx, key, refPlatform); err != nil {
+			if errors.IsNotFound(err) {
+				return 0, nil
+			}
+			return 0, err
+		}
+		return refPlatform.Spec.Build.Config.GetMaxConcurrentClusterBuilds(), nil
+	}
+	return 0, nil
+}
+
+// admitWithin verifies if the build fits in the given limit considering the builds matching the scope.
+// New builds created before the given one are always ahead of it, so that builds reconciled close together, before
+// their status is seen by each other, can't exceed the limit.
+// Returns the build position in the queue for that scope, zero if the build is admitted.
+func admitWithin(build *operatorapi.SonataFlowBuild, builds []operatorapi.SonataFlowBuild, limit int32, inScope func(b *operatorapi.SonataFlowBuild) bool) (bool, int32) {
+	var running, ahead int32
+	waiting := []*operatorapi.SonataFlowBuild{build}
+	for i := range builds {
+		b := &builds[i]
+		if !inScope(b) || (b.Namespace == build.Namespace && b.Name == build.Name) {
+			continue
+		}
+		if IsBuildActive(b) {
+			running++
+		} else if b.Status.BuildPhase == operatorapi.BuildPhaseQueued {
+			waiting = append(waiting, b)
+		} else if b.Status.BuildPhase == operatorapi.BuildPhaseNone && createdBefore(b, build) {
+			ahead++
+		}
+	}
+	sortQueue(waiting)
+	for i, b := range waiting {
+		if b.Namespace == build.Namespace && b.Name == build.Name {
+			if running+ahead+int32(i) < limit {
+				return true, 0
+			}
+			return false, ahead + int32(i) + 1
+		}
+	}
+	return false, ahead + int32(len(waiting))
+}
+
+// createdBefore returns true if the build a was created before the build b, the name breaks the ties of builds created in the same second.
+func createdBefore(a, b *operatorapi.SonataFlowBuild) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
+
+// sortQueue orders the queued builds by priority, then by the time they entered the queue.
+func sortQueue(builds []*operatorapi.SonataFlowBuild) {
+	sort.SliceStable(builds, func(i, j int) bool {
+		if builds[i].Spec.Priority != builds[j].Spec.Priority {
+			return builds[i].Spec.Priority > builds[j].Spec.Priority
+		}
+		ti, tj := queuedTime(builds[i]), queuedTime(builds[j])
+		if !ti.Equal(&tj) {
+			return ti.Before(&tj)
+		}
+		if builds[i].Namespace != builds[j].Namespace {
+			return builds[i].Namespace < builds[j].Namespace
+		}
+		return builds[i].Name < builds[j].Name
+	})
+}
+
+func queuedTime(build *operatorapi.SonataFlowBuild) metav1.Time {
+	if build.Status.QueuedAt != nil {
+		return *build.Status.QueuedAt
+	}
+	return build.CreationTimestamp
+}
+
+func dequeue(build *operatorapi.SonataFlowBuild) {
+	build.Status.QueuePosition = 0
+	build.Status.QueuedAt = nil
+}
+
+// IsBuildActive returns true if the given build is taking a build slot in the platform.
+func IsBuildActive(build *operatorapi.SonataFlowBuild) bool {
+	switch build.Status.BuildPhase {
+	case operatorapi.BuildPhaseInitialization,
+		operatorapi.BuildPhaseScheduling,
+		operatorapi.BuildPhasePending,
+		operatorapi.BuildPhaseRunning:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package builder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/test"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
+)
+
+func newBuildInPhase(name, namespace string, phase operatorapi.BuildPhase) *operatorapi.SonataFlowBuild {
+	build := test.GetNewEmptySonataFlowBuild(name, namespace)
+	build.Status.BuildPhase = phase
+	return build
+}
+
+func TestBuildQueue_AdmitWithoutLimits(t *testing.T) {
+	namespace := t.Name()
+	build := newBuildInPhase("new", namespace, operatorapi.BuildPhaseNone)
+	cli := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(test.GetBasePlatformInReadyPhase(namespace), build,
+			newBuildInPhase("running", namespace, operatorapi.BuildPhaseRunning)).
+		Build()
+
+	admitted, err := NewBuildQueue(context.TODO(), cli).Admit(build)
+	assert.NoError(t, err)
+	assert.True(t, admitted)
+	assert.Equal(t, operatorapi.BuildPhaseNone, build.Status.BuildPhase)
+	assert.Nil(t, build.Status.QueuedAt)
+}
+
+func TestBuildQueue_QueueWhenNamespaceLimitReached(t *testing.T) {
+	namespace := t.Name()
+	plat := test.GetBasePlatformInReadyPhase(namespace)
+	plat.Spec.Build.Config.MaxConcurrentBuilds = utils.Pint(1)
+	build := newBuildInPhase("new", namespace, operatorapi.BuildPhaseNone)
+	cli := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(plat, build,
+			newBuildInPhase("running", namespace, operatorapi.BuildPhaseRunning),
+			newBuildInPhase("other-namespace", "other", operatorapi.BuildPhaseRunning)).
+		Build()
+
+	admitted, err := NewBuildQueue(context.TODO(), cli).Admit(build)
+	assert.NoError(t, err)
+	assert.False(t, admitted)
+	assert.Equal(t, operatorapi.BuildPhaseQueued, build.Status.BuildPhase)
+	assert.Equal(t, int32(1), build.Status.QueuePosition)
+	assert.NotNil(t, build.Status.QueuedAt)
+}
+
+func TestBuildQueue_AdmitInPriorityOrder(t *testing.T) {
+	namespace := t.Name()
+	plat := test.GetBasePlatformInReadyPhase(namespace)
+	plat.Spec.Build.Config.MaxConcurrentBuilds = utils.Pint(2)
+	older := metav1.NewTime(time.Now().Add(-time.Hour))
+	first := newBuildInPhase("first", namespace, operatorapi.BuildPhaseQueued)
+	first.Status.QueuedAt = &older
+	urgent := newBuildInPhase("urgent", namespace, operatorapi.BuildPhaseQueued)
+	urgent.Spec.Priority = 10
+	urgent.Status.QueuedAt = &metav1.Time{Time: time.Now()}
+	cli := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(plat, first, urgent,
+			newBuildInPhase("running", namespace, operatorapi.BuildPhaseRunning),
+			newBuildInPhase("done", namespace, operatorapi.BuildPhaseSucceeded)).
+		Build()
+
+	queue := NewBuildQueue(context.TODO(), cli)
+	admitted, err := queue.Admit(first)
+	assert.NoError(t, err)
+	assert.False(t, admitted)
+	assert.Equal(t, int32(2), first.Status.QueuePosition)
+
+	admitted, err = queue.Admit(urgent)
+	assert.NoError(t, err)
+	assert.True(t, admitted)
+	assert.Equal(t, int32(0), urgent.Status.QueuePosition)
+	assert.Nil(t, urgent.Status.QueuedAt)
+}
+
+func TestBuildQueue_QueueWhenClusterLimitReached(t *testing.T) {
+	namespace := t.Name()
+	clusterPlat := test.GetBaseClusterPlatformInReadyPhase("platform-" + namespace)
+	refPlat := test.GetBasePlatformInReadyPhase(clusterPlat.Spec.PlatformRef.Namespace)
+	refPlat.Name = clusterPlat.Spec.PlatformRef.Name
+	refPlat.Spec.Build.Config.MaxConcurrentClusterBuilds = utils.Pint(1)
+	build := newBuildInPhase("new", namespace, operatorapi.BuildPhaseNone)
+	cli := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(clusterPlat, refPlat, test.GetBasePlatformInReadyPhase(namespace), build,
+			newBuildInPhase("other-namespace", "other", operatorapi.BuildPhaseRunning)).
+		Build()
+
+	admitted, err := NewBuildQueue(context.TODO(), cli).Admit(build)
+	assert.NoError(t, err)
+	assert.False(t, admitted)
+	assert.Equal(t, operatorapi.BuildPhaseQueued, build.Status.BuildPhase)
+	assert.Equal(t, int32(1), build.Status.QueuePosition)
+}
+
+func TestBuildQueue_CountNewBuildsCreatedEarlier(t *testing.T) {
+	namespace := t.Name()
+	plat := test.GetBasePlatformInReadyPhase(namespace)
+	plat.Spec.Build.Config.MaxConcurrentBuilds = utils.Pint(1)
+	first := newBuildInPhase("first", namespace, operatorapi.BuildPhaseNone)
+	first.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Minute))
+	second := newBuildInPhase("second", namespace, operatorapi.BuildPhaseNone)
+	second.CreationTimestamp = metav1.NewTime(time.Now())
+	cli := test.NewSonataFlowClientBuilder().WithRuntimeObjects(plat, first, second).Build()
+
+	// none of the builds has been admitted yet, only the oldest one fits
+	queue := NewBuildQueue(context.TODO(), cli)
+	admitted, err := queue.Admit(second)
+	assert.NoError(t, err)
+	assert.False(t, admitted)
+	assert.Equal(t, int32(2), second.Status.QueuePosition)
+
+	admitted, err = queue.Admit(first)
+	assert.NoError(t, err)
+	assert.True(t, admitted)
+}
@@ -32,6 +32,7 @@ import (
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/log"
 )
 
@@ -39,6 +40,7 @@ const (
 	envVarPodNamespaceName     = "POD_NAMESPACE"
 	configKeyDefaultExtension  = "DEFAULT_WORKFLOW_EXTENSION"
 	defaultBuilderResourceName = "Dockerfile"
+	projectBuilderResourceName = "ProjectDockerfile"
 )
 
 // GetBuilderConfigMap retrieves the config map with the builder common configuration information
@@ -93,3 +95,15 @@ func isValidBuilderConfigMap(configMap *corev1.ConfigMap) error {
 	}
 	return nil
 }
+
+// getBuilderDockerfile returns the Dockerfile from the builder config map used to build the given SonataFlowBuild.
+// Builds from a Git source use the project Dockerfile, the others package the workflow definition with the default one.
+func getBuilderDockerfile(configMap *corev1.ConfigMap, build *operatorapi.SonataFlowBuild) (string, error) {
+	if build.Spec.Git == nil {
+		return configMap.Data[defaultBuilderResourceName], nil
+	}
+	if len(configMap.Data[projectBuilderResourceName]) == 0 {
+		return "", fmt.Errorf("unable to find %s key into builder config map, required to build workflows from Git sources", projectBuilderResourceName)
+	}
+	return configMap.Data[projectBuilderResourceName], nil
+}
@@ -59,6 +59,8 @@ type kanikoBuildInput struct {
 	workflowProperties []operatorapi.ConfigMapWorkflowResource
 	dockerfile         string
 	imageTag           string
+	gitSource          *api.GitSource
+	retryPolicy        *api.RetryPolicy
 }
 
 type containerBuilderManager struct {
@@ -72,12 +74,17 @@ func (c *containerBuilderManager) Schedule(build *operatorapi.SonataFlowBuild) e
 	if platform.IsKanikoCacheEnabled(c.platform) {
 		kanikoTaskCache.Enabled = utils.Pbool(true)
 	}
+	mavenConfig := c.platform.Spec.Build.Config.Maven
+	volumes, volumeMounts := mavenVolumes(mavenConfig)
 	kanikoTask := &api.KanikoTask{
 		ContainerBuildBaseTask: api.ContainerBuildBaseTask{
-			Name:      "kaniko",
-			BuildArgs: build.Spec.BuildArgs,
-			Envs:      build.Spec.Envs,
-			Resources: build.Spec.Resources,
+			Name:         "kaniko",
+			BuildArgs:    withMavenBuildArgs(build.Spec.BuildArgs, mavenConfig, true),
+			Envs:         build.Spec.Envs,
+			Resources:    build.Spec.Resources,
+			Volumes:      volumes,
+			VolumeMounts: volumeMounts,
+			PodTemplate:  toContainerBuilderPodTemplate(c.platform.Spec.Build.Config.GetBuilderPodTemplate(&build.Spec.BuildTemplate)),
 		},
 		PublishTask:         api.PublishTask{},
 		Cache:               kanikoTaskCache,
@@ -116,6 +123,8 @@ func (c *containerBuilderManager) Reconcile(build *operatorapi.SonataFlowBuild)
 	build.Status.BuildPhase = operatorapi.BuildPhase(containerBuild.Status.Phase)
 	build.Status.Error = containerBuild.Status.Error
 	build.Status.ImageTag = containerBuild.Status.RepositoryImageTag
+	build.Status.GitCommit = containerBuild.Status.GitCommit
+	updateRetryStatus(build, containerBuild)
 	if err = build.Status.SetInnerBuild(containerBuild); err != nil {
 		return err
 	}
@@ -134,7 +143,7 @@ func (c *containerBuilderManager) scheduleNewKanikoBuildWithContainerFile(build
 	if err != nil {
 		return nil, err
 	}
-	workflowDef, err := workflowdef.GetJSONWorkflow(workflow, c.ctx)
+	dockerfile, err := getBuilderDockerfile(c.builderConfigMap, build)
 	if err != nil {
 		return nil, err
 	}
@@ -142,11 +151,16 @@ func (c *containerBuilderManager) scheduleNewKanikoBuildWithContainerFile(build
 	buildInput := kanikoBuildInput{
 		name:               workflow.Name,
 		task:               task,
-		workflowDefinition: workflowDef,
 		workflow:           workflow,
 		workflowProperties: buildWorkflowPropertyResources(workflow),
-		dockerfile:         platform.GetCustomizedBuilderDockerfile(c.builderConfigMap.Data[defaultBuilderResourceName], *c.platform),
+		dockerfile:         platform.GetCustomizedBuilderDockerfile(dockerfile, *c.platform),
 		imageTag:           buildNamespacedImageTag(workflow),
+		retryPolicy:        toContainerBuilderRetryPolicy(c.platform.Spec.Build.Config.RetryPolicy),
+	}
+	if build.Spec.Git != nil {
+		buildInput.gitSource = toContainerBuilderGitSource(build.Spec.Git)
+	} else if buildInput.workflowDefinition, err = workflowdef.GetJSONWorkflow(workflow, c.ctx); err != nil {
+		return nil, err
 	}
 
 	if c.platform.Spec.Build.Config.Timeout == nil {
@@ -196,26 +210,83 @@ func newBuild(buildInput kanikoBuildInput, platform api.PlatformContainerBuild,
 		BuildUniqueName:          buildInput.name,
 		Platform:                 platform,
 		ContainerBuilderImageTag: buildInput.task.KanikoExecutorImage,
+		GitSource:                buildInput.gitSource,
+		RetryPolicy:              buildInput.retryPolicy,
 	}
 
 	newBuilder := builder.NewBuild(buildInfo).
 		WithClient(cli).
-		AddResource(resourceDockerfile, []byte(buildInput.dockerfile)).
-		AddResource(buildInput.name+defaultExtension, buildInput.workflowDefinition)
-	for _, res := range buildInput.workflow.Spec.Resources.ConfigMaps {
-		newBuilder.AddConfigMapResource(res.ConfigMap, res.WorkflowPath)
-	}
+		AddResource(resourceDockerfile, []byte(buildInput.dockerfile))
+	// the project cloned from Git already carries the workflow definitions and their resources
+	if buildInput.gitSource == nil {
+		newBuilder.AddResource(buildInput.name+defaultExtension, buildInput.workflowDefinition)
+		for _, res := range buildInput.workflow.Spec.Resources.ConfigMaps {
+			newBuilder.AddConfigMapResource(res.ConfigMap, res.WorkflowPath)
+		}
 
-	//make the workflow properties available to the kaniko build.
-	for _, props := range buildInput.workflowProperties {
-		newBuilder.AddConfigMapResource(props.ConfigMap, props.WorkflowPath)
+		//make the workflow properties available to the kaniko build.
+		for _, props := range buildInput.workflowProperties {
+			newBuilder.AddConfigMapResource(props.ConfigMap, props.WorkflowPath)
+		}
 	}
 
 	return newBuilder.Scheduler().
 		WithAdditionalArgs(buildInput.task.AdditionalFlags).
 		WithResourceRequirements(buildInput.task.Resources).
 		WithBuildArgs(buildInput.task.BuildArgs).
-		WithEnvs(buildInput.task.Envs).Schedule()
+		WithEnvs(buildInput.task.Envs).
+		WithVolumes(buildInput.task.Volumes, buildInput.task.VolumeMounts).
+		WithPodTemplate(buildInput.task.PodTemplate).Schedule()
+}
+
+// updateRetryStatus reflects the container-builder error recovery in the SonataFlowBuild status.
+func updateRetryStatus(build *operatorapi.SonataFlowBuild, containerBuild *api.ContainerBuild) {
+	build.Status.NextRetryAt = nil
+	failure := containerBuild.Status.Failure
+	if failure != nil {
+		build.Status.RetryAttempts = int32(failure.Recovery.Attempt)
+	}
+	if containerBuild.Status.Phase != api.ContainerBuildPhaseFailed {
+		return
+	}
+	lastAttempt := time.Now()
+	if failure != nil {
+		lastAttempt = failure.Time.Time
+		if !failure.Recovery.AttemptTime.IsZero() {
+			lastAttempt = failure.Recovery.AttemptTime.Time
+		}
+	}
+	build.Status.NextRetryAt = nextRetry(containerBuild.Spec.RetryPolicy, build.Status.RetryAttempts, lastAttempt, containerBuild.Status.Error)
+}
+
+// toContainerBuilderGitSource converts the SonataFlowBuild Git source to the container-builder one.
+func toContainerBuilderGitSource(source *operatorapi.GitSource) *api.GitSource {
+	gitSource := &api.GitSource{
+		URL:                             source.URL,
+		Revision:                        source.Revision,
+		ContextDir:                      source.ContextDir,
+		CloneImage:                      cfg.GetCfg().GitCloneImageTag,
+		InsecureSkipHostKeyVerification: source.InsecureSkipHostKeyVerification,
+	}
+	if source.SecretRef != nil {
+		gitSource.Secret = source.SecretRef.Name
+	}
+	return gitSource
+}
+
+// toContainerBuilderPodTemplate converts the builder pod template to the container-builder one.
+func toContainerBuilderPodTemplate(template *operatorapi.BuilderPodTemplateSpec) *api.PodTemplate {
+	if template == nil {
+		return nil
+	}
+	return &api.PodTemplate{
+		NodeSelector:       template.NodeSelector,
+		Tolerations:        template.Tolerations,
+		Affinity:           template.Affinity,
+		PriorityClassName:  template.PriorityClassName,
+		ServiceAccountName: template.ServiceAccountName,
+		ImagePullSecrets:   template.ImagePullSecrets,
+	}
 }
 
 // buildNamespacedImageTag For the kaniko build we prepend the namespace to the calculated image name/tag to avoid potential
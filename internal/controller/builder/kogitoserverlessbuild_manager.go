@@ -21,19 +21,26 @@ package builder
 
 import (
 	"context"
+	"strconv"
 	"strings"
 
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/cfg"
 
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/persistence"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/security"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/kafka"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/platform"
 
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api/metadata"
 	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/log"
 )
 
 const QuarkusExtensionsBuildArg = "QUARKUS_EXTENSIONS"
@@ -65,7 +72,20 @@ func (k *sonataFlowBuildManager) GetOrCreateBuild(workflow *operatorapi.SonataFl
 			if persistence.UsesPostgreSQLPersistence(workflow, plat) {
 				addPersistenceExtensions(workflowBuildTemplate)
 			}
+			if security.UsesOIDC(workflow, plat) {
+				addOIDCExtensions(workflowBuildTemplate)
+			}
+			if security.UsesFunctionOIDCClient(workflow) {
+				addOIDCClientExtensions(workflowBuildTemplate)
+			}
+			if kafkaPlatform, err := kafka.GetWorkflowKafkaPlatform(workflow, plat); err != nil {
+				return nil, err
+			} else if kafkaPlatform != nil {
+				addKafkaExtensions(workflowBuildTemplate)
+			}
 			buildInstance.Spec.BuildTemplate = *workflowBuildTemplate
+			buildInstance.Spec.Priority = getBuildPriority(workflow)
+			buildInstance.Spec.Git = workflow.Spec.Git.DeepCopy()
 			if err = controllerutil.SetControllerReference(workflow, buildInstance, k.client.Scheme()); err != nil {
 				return nil, err
 			}
@@ -76,10 +96,41 @@ func (k *sonataFlowBuildManager) GetOrCreateBuild(workflow *operatorapi.SonataFl
 		}
 		return nil, err
 	}
+	if priority := getBuildPriority(workflow); buildInstance.Spec.Priority != priority {
+		buildInstance.Spec.Priority = priority
+		if err := k.client.Update(k.ctx, buildInstance); err != nil {
+			return nil, err
+		}
+	}
 
 	return buildInstance, nil
 }
 
+// getBuildPriority gets the build priority set in the workflow metadata.BuildPriority annotation, zero if not set or invalid.
+func getBuildPriority(workflow *operatorapi.SonataFlow) int32 {
+	value, ok := workflow.Annotations[metadata.BuildPriority]
+	if !ok {
+		return 0
+	}
+	priority, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		klog.V(log.E).ErrorS(err, "Invalid build priority, using the default one", "workflow", workflow.Name, "annotation", metadata.BuildPriority)
+		return 0
+	}
+	return int32(priority)
+}
+
+func (k *sonataFlowBuildManager) SyncGitSource(workflow *operatorapi.SonataFlow, build *operatorapi.SonataFlowBuild) (bool, error) {
+	if equality.Semantic.DeepEqual(workflow.Spec.Git, build.Spec.Git) {
+		return false, nil
+	}
+	build.Spec.Git = workflow.Spec.Git.DeepCopy()
+	if err := k.client.Update(k.ctx, build); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 type SonataFlowBuildManager interface {
 	// GetOrCreateBuild gets or creates a new instance of SonataFlowBuild for the given SonataFlow.
 	//
@@ -87,6 +138,9 @@ type SonataFlowBuildManager interface {
 	GetOrCreateBuild(workflow *operatorapi.SonataFlow) (*operatorapi.SonataFlowBuild, error)
 	// MarkToRestart tell the controller to restart this build in the next iteration
 	MarkToRestart(build *operatorapi.SonataFlowBuild) error
+	// SyncGitSource copies the Git source from the given SonataFlow to its SonataFlowBuild.
+	// Returns true if the Git source has changed, meaning that the build must be restarted.
+	SyncGitSource(workflow *operatorapi.SonataFlow, build *operatorapi.SonataFlowBuild) (bool, error)
 }
 
 // NewSonataFlowBuildManager entry point to manage SonataFlowBuild instances.
@@ -102,13 +156,32 @@ func NewSonataFlowBuildManager(ctx context.Context, client client.Client) Sonata
 // already provided. If any of them is detected, its assumed that users might already have provided them in the
 // SonataFlowPlatform, so we just let the provided configuration.
 func addPersistenceExtensions(template *operatorapi.BuildTemplate) {
+	addExtensions(template, persistence.GetPostgreSQLExtensions())
+}
+
+// addOIDCExtensions Adds the OIDC related extensions to the current BuildTemplate if none of them is already provided.
+func addOIDCExtensions(template *operatorapi.BuildTemplate) {
+	addExtensions(template, security.GetOIDCExtensions())
+}
+
+// addOIDCClientExtensions Adds the OIDC client related extensions to the current BuildTemplate if none of them is already provided.
+func addOIDCClientExtensions(template *operatorapi.BuildTemplate) {
+	addExtensions(template, security.GetOIDCClientExtensions())
+}
+
+// addKafkaExtensions Adds the Kafka eventing related extensions to the current BuildTemplate if none of them is already provided.
+func addKafkaExtensions(template *operatorapi.BuildTemplate) {
+	addExtensions(template, kafka.GetKafkaExtensions())
+}
+
+func addExtensions(template *operatorapi.BuildTemplate, extensions []cfg.GAV) {
 	quarkusExtensions := getBuildArg(template.BuildArgs, QuarkusExtensionsBuildArg)
 	if quarkusExtensions == nil {
 		template.BuildArgs = append(template.BuildArgs, v1.EnvVar{Name: QuarkusExtensionsBuildArg})
 		quarkusExtensions = &template.BuildArgs[len(template.BuildArgs)-1]
 	}
-	if !hasAnyExtensionPresent(quarkusExtensions, persistence.GetPostgreSQLExtensions()) {
-		for _, extension := range persistence.GetPostgreSQLExtensions() {
+	if !hasAnyExtensionPresent(quarkusExtensions, extensions) {
+		for _, extension := range extensions {
 			if len(quarkusExtensions.Value) > 0 {
 				quarkusExtensions.Value = quarkusExtensions.Value + ","
 			}
@@ -135,6 +208,14 @@ func hasAnyExtensionPresent(buildArg *v1.EnvVar, extensions []cfg.GAV) bool {
 	return false
 }
 
+// isExtensionPresent compares the whole group and artifact of each listed extension, so that, for example,
+// io.quarkus:quarkus-oidc-client doesn't stand for io.quarkus:quarkus-oidc.
 func isExtensionPresent(buildArg *v1.EnvVar, extension cfg.GAV) bool {
-	return strings.Contains(buildArg.Value, extension.GroupAndArtifact())
+	for _, value := range strings.Split(buildArg.Value, ",") {
+		value = strings.TrimSpace(value)
+		if value == extension.GroupAndArtifact() || strings.HasPrefix(value, extension.GroupAndArtifact()+":") {
+			return true
+		}
+	}
+	return false
 }
@@ -18,8 +18,10 @@
 package builder
 
 import (
+	"context"
 	"testing"
 
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api/metadata"
 	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/cfg"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/persistence"
@@ -92,6 +94,56 @@ func TestSonataFlowBuildManager_GetOrCreateBuildWithNoPersistence(t *testing.T)
 	test.RestoreControllersConfig(t)
 }
 
+func TestSonataFlowBuildManager_GetOrCreateBuildWithPriority(t *testing.T) {
+	currentPlatform := operatorapi.SonataFlowPlatform{
+		ObjectMeta: metav1.ObjectMeta{Name: "current-platform"},
+	}
+	workflow := operatorapi.SonataFlow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-workflow",
+			Annotations: map[string]string{metadata.BuildPriority: "10"},
+		},
+	}
+	buildManager := prepareGetOrCreateBuildTest(t, &currentPlatform)
+	build, err := buildManager.GetOrCreateBuild(&workflow)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(10), build.Spec.Priority)
+
+	// the priority follows the workflow annotation
+	workflow.Annotations[metadata.BuildPriority] = "-5"
+	build, err = buildManager.GetOrCreateBuild(&workflow)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(-5), build.Spec.Priority)
+
+	workflow.Annotations[metadata.BuildPriority] = "urgent"
+	assert.Equal(t, int32(0), getBuildPriority(&workflow))
+	test.RestoreControllersConfig(t)
+}
+
+func TestSonataFlowBuildManager_SyncGitSource(t *testing.T) {
+	namespace := t.Name()
+	workflow := test.GetBaseSonataFlow(namespace)
+	workflow.Spec.Git = &operatorapi.GitSource{URL: "https://github.com/my-org/my-workflow.git", Revision: "main"}
+	cli := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(test.GetBasePlatformInReadyPhase(namespace), workflow).
+		Build()
+	buildManager := NewSonataFlowBuildManager(context.TODO(), cli)
+
+	build, err := buildManager.GetOrCreateBuild(workflow)
+	assert.NoError(t, err)
+	assert.Equal(t, workflow.Spec.Git, build.Spec.Git)
+
+	changed, err := buildManager.SyncGitSource(workflow, build)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	workflow.Spec.Git.Revision = "v1.0.0"
+	changed, err = buildManager.SyncGitSource(workflow, build)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "v1.0.0", build.Spec.Git.Revision)
+}
+
 func testGetOrCreateBuildWithPersistence(t *testing.T, currentPlatform *operatorapi.SonataFlowPlatform, workflow *operatorapi.SonataFlow) {
 	buildManager := prepareGetOrCreateBuildTest(t, currentPlatform)
 	build, _ := buildManager.GetOrCreateBuild(workflow)
@@ -163,6 +215,41 @@ func Test_addPersistenceExtensionsWithQuarkusExtensionsArgAndPersistenceExtensio
 	test.RestoreControllersConfig(t)
 }
 
+func Test_addOIDCExtensionsWithPersistenceExtensions(t *testing.T) {
+	initializeControllersConfig(t)
+	buildTemplate := &operatorapi.BuildTemplate{}
+	addPersistenceExtensions(buildTemplate)
+	addOIDCExtensions(buildTemplate)
+	assert.Equal(t, 1, len(buildTemplate.BuildArgs))
+	assertContainsPersistence(t, buildTemplate.BuildArgs, 0)
+	assert.Contains(t, buildTemplate.BuildArgs[0].Value, "io.quarkus:quarkus-oidc:3.8.6")
+	test.RestoreControllersConfig(t)
+}
+
+func Test_addOIDCClientExtensionsWithOIDCExtensions(t *testing.T) {
+	initializeControllersConfig(t)
+	buildTemplate := &operatorapi.BuildTemplate{}
+	addOIDCExtensions(buildTemplate)
+	addOIDCClientExtensions(buildTemplate)
+	assert.Equal(t, 1, len(buildTemplate.BuildArgs))
+	assert.Equal(t, "io.quarkus:quarkus-oidc:3.8.6,io.quarkus:quarkus-oidc-client:3.8.6", buildTemplate.BuildArgs[0].Value)
+
+	buildTemplate = &operatorapi.BuildTemplate{}
+	addOIDCClientExtensions(buildTemplate)
+	addOIDCExtensions(buildTemplate)
+	assert.Equal(t, "io.quarkus:quarkus-oidc-client:3.8.6,io.quarkus:quarkus-oidc:3.8.6", buildTemplate.BuildArgs[0].Value)
+	test.RestoreControllersConfig(t)
+}
+
+func Test_addKafkaExtensions(t *testing.T) {
+	initializeControllersConfig(t)
+	buildTemplate := &operatorapi.BuildTemplate{}
+	addKafkaExtensions(buildTemplate)
+	assert.Equal(t, 1, len(buildTemplate.BuildArgs))
+	assert.Equal(t, "io.quarkus:quarkus-smallrye-reactive-messaging-kafka:3.8.6", buildTemplate.BuildArgs[0].Value)
+	test.RestoreControllersConfig(t)
+}
+
 func initializeControllersConfig(t *testing.T) {
 	// emulate the controllers config initialization
 	cfg, err := cfg.InitializeControllersCfgAt("../cfg/testdata/controllers-cfg-test.yaml")
@@ -0,0 +1,190 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package builder
+
+import (
+	"encoding/xml"
+	"path"
+	"strings"
+
+	buildv1 "github.com/openshift/api/build/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+)
+
+const (
+	mavenArgsAppendBuildArg   = "MAVEN_ARGS_APPEND"
+	mavenMirrorURLBuildArg    = "MAVEN_MIRROR_URL"
+	mavenSettingsVolumeName   = "maven-settings"
+	mavenRepositoryVolumeName = "maven-repository"
+	mavenCABundleVolumeName   = "maven-ca-bundle"
+	mavenSettingsMountPath    = "/maven/settings"
+	mavenRepositoryMountPath  = "/maven/repository"
+	mavenCABundleMountPath    = "/maven/ca"
+	mavenSettingsFileName     = "settings.xml"
+	mavenCABundleFileName     = "ca.crt"
+	// mavenMirrorSettingsPath global settings file holding the mirror, written by the builder Dockerfiles from MAVEN_MIRROR_URL
+	mavenMirrorSettingsPath = "/home/kogito/.m2/mirror-settings.xml"
+	// mavenTrustStorePath trust store holding the CA bundle certificates, written by the builder Dockerfiles
+	mavenTrustStorePath     = "/home/kogito/.m2/truststore"
+	mavenTrustStorePassword = "changeit"
+)
+
+// withMavenBuildArgs returns a copy of the given build args adding the arguments required by the platform Maven configuration.
+// The Maven arguments are appended to the MAVEN_ARGS_APPEND build arg already defined by users, if any.
+// The local repository and the offline mode are skipped unless the builds can mount the local repository, see mavenVolumes.
+func withMavenBuildArgs(buildArgs []corev1.EnvVar, maven *operatorapi.MavenBuildConfig, mountsLocalRepository bool) []corev1.EnvVar {
+	result := make([]corev1.EnvVar, len(buildArgs))
+	copy(result, buildArgs)
+	if maven == nil {
+		return result
+	}
+
+	var mavenArgs []string
+	if len(maven.MirrorURL) > 0 {
+		// the URL is written as is in the mirror settings file
+		result = append(result, corev1.EnvVar{Name: mavenMirrorURLBuildArg, Value: xmlEscape(maven.MirrorURL)})
+		mavenArgs = append(mavenArgs, "-gs "+mavenMirrorSettingsPath)
+	}
+	if mavenSettingsVolumeSource(maven) != nil {
+		mavenArgs = append(mavenArgs, "-s "+path.Join(mavenSettingsMountPath, mavenSettingsFileName))
+	}
+	if mountsLocalRepository {
+		if len(maven.LocalRepository) > 0 {
+			mavenArgs = append(mavenArgs, "-Dmaven.repo.local="+mavenRepositoryMountPath)
+		}
+		if maven.Offline {
+			mavenArgs = append(mavenArgs, "-o")
+		}
+	}
+	if maven.CABundle != nil {
+		mavenArgs = append(mavenArgs,
+			"-Djavax.net.ssl.trustStore="+mavenTrustStorePath,
+			"-Djavax.net.ssl.trustStorePassword="+mavenTrustStorePassword)
+	}
+	if len(mavenArgs) == 0 {
+		return result
+	}
+
+	argsAppend := getBuildArg(result, mavenArgsAppendBuildArg)
+	if argsAppend == nil {
+		result = append(result, corev1.EnvVar{Name: mavenArgsAppendBuildArg})
+		argsAppend = &result[len(result)-1]
+	}
+	if len(argsAppend.Value) > 0 {
+		mavenArgs = append([]string{argsAppend.Value}, mavenArgs...)
+	}
+	argsAppend.Value = strings.Join(mavenArgs, " ")
+	return result
+}
+
+// xmlEscape escapes the given text to be used as an XML element value.
+func xmlEscape(text string) string {
+	var escaped strings.Builder
+	_ = xml.EscapeText(&escaped, []byte(text))
+	return escaped.String()
+}
+
+// mavenVolumes returns the volumes, and where to mount them, required by the platform Maven configuration in the builder pods.
+func mavenVolumes(maven *operatorapi.MavenBuildConfig) ([]corev1.Volume, []corev1.VolumeMount) {
+	if maven == nil {
+		return nil, nil
+	}
+	var volumes []corev1.Volume
+	var mounts []corev1.VolumeMount
+	if source := mavenSettingsVolumeSource(maven); source != nil {
+		volumes = append(volumes, corev1.Volume{Name: mavenSettingsVolumeName, VolumeSource: *source})
+		mounts = append(mounts, corev1.VolumeMount{Name: mavenSettingsVolumeName, MountPath: mavenSettingsMountPath, ReadOnly: true})
+	}
+	if len(maven.LocalRepository) > 0 {
+		volumes = append(volumes, corev1.Volume{
+			Name: mavenRepositoryVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: maven.LocalRepository},
+			},
+		})
+		mounts = append(mounts, corev1.VolumeMount{Name: mavenRepositoryVolumeName, MountPath: mavenRepositoryMountPath})
+	}
+	if maven.CABundle != nil {
+		volumes = append(volumes, corev1.Volume{
+			Name:         mavenCABundleVolumeName,
+			VolumeSource: corev1.VolumeSource{ConfigMap: mavenCABundleVolumeSource(maven)},
+		})
+		mounts = append(mounts, corev1.VolumeMount{Name: mavenCABundleVolumeName, MountPath: mavenCABundleMountPath, ReadOnly: true})
+	}
+	return volumes, mounts
+}
+
+// mavenBuildVolumes returns the OpenShift build volumes required by the platform Maven configuration.
+// The local repository is not included since OpenShift builds don't support persistent volumes.
+func mavenBuildVolumes(maven *operatorapi.MavenBuildConfig) []buildv1.BuildVolume {
+	if maven == nil {
+		return nil
+	}
+	var volumes []buildv1.BuildVolume
+	if source := mavenSettingsVolumeSource(maven); source != nil {
+		volume := buildv1.BuildVolume{
+			Name:   mavenSettingsVolumeName,
+			Mounts: []buildv1.BuildVolumeMount{{DestinationPath: mavenSettingsMountPath}},
+		}
+		if source.Secret != nil {
+			volume.Source = buildv1.BuildVolumeSource{Type: buildv1.BuildVolumeSourceTypeSecret, Secret: source.Secret}
+		} else {
+			volume.Source = buildv1.BuildVolumeSource{Type: buildv1.BuildVolumeSourceTypeConfigMap, ConfigMap: source.ConfigMap}
+		}
+		volumes = append(volumes, volume)
+	}
+	if maven.CABundle != nil {
+		volumes = append(volumes, buildv1.BuildVolume{
+			Name:   mavenCABundleVolumeName,
+			Source: buildv1.BuildVolumeSource{Type: buildv1.BuildVolumeSourceTypeConfigMap, ConfigMap: mavenCABundleVolumeSource(maven)},
+			Mounts: []buildv1.BuildVolumeMount{{DestinationPath: mavenCABundleMountPath}},
+		})
+	}
+	return volumes
+}
+
+// mavenSettingsVolumeSource projects the settings key to the settings.xml file, nil if no settings are configured.
+func mavenSettingsVolumeSource(maven *operatorapi.MavenBuildConfig) *corev1.VolumeSource {
+	if maven.Settings == nil {
+		return nil
+	}
+	if ref := maven.Settings.SecretKeyRef; ref != nil {
+		return &corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+			SecretName: ref.Name,
+			Items:      []corev1.KeyToPath{{Key: ref.Key, Path: mavenSettingsFileName}},
+		}}
+	}
+	if ref := maven.Settings.ConfigMapKeyRef; ref != nil {
+		return &corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: ref.LocalObjectReference,
+			Items:                []corev1.KeyToPath{{Key: ref.Key, Path: mavenSettingsFileName}},
+		}}
+	}
+	return nil
+}
+
+func mavenCABundleVolumeSource(maven *operatorapi.MavenBuildConfig) *corev1.ConfigMapVolumeSource {
+	return &corev1.ConfigMapVolumeSource{
+		LocalObjectReference: maven.CABundle.LocalObjectReference,
+		Items:                []corev1.KeyToPath{{Key: maven.CABundle.Key, Path: mavenCABundleFileName}},
+	}
+}
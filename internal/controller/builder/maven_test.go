@@ -0,0 +1,92 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package builder
+
+import (
+	"testing"
+
+	buildv1 "github.com/openshift/api/build/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+)
+
+func newTestMavenBuildConfig() *operatorapi.MavenBuildConfig {
+	return &operatorapi.MavenBuildConfig{
+		Settings: &operatorapi.MavenSettingsSource{
+			SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "maven-settings"}, Key: "settings.xml"},
+		},
+		MirrorURL:       "https://nexus.example.com/repository/maven-public/",
+		LocalRepository: "maven-repo",
+		Offline:         true,
+		CABundle:        &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "nexus-ca"}, Key: "ca-bundle.crt"},
+	}
+}
+
+func Test_withMavenBuildArgs(t *testing.T) {
+	buildArgs := []corev1.EnvVar{{Name: mavenArgsAppendBuildArg, Value: "-DmyProperty=true"}}
+	result := withMavenBuildArgs(buildArgs, newTestMavenBuildConfig(), true)
+
+	assert.Equal(t, "-DmyProperty=true", buildArgs[0].Value, "the original build args must not be modified")
+	assert.Len(t, result, 2)
+	assert.Equal(t, "-DmyProperty=true -gs "+mavenMirrorSettingsPath+" -s /maven/settings/settings.xml "+
+		"-Dmaven.repo.local=/maven/repository -o -Djavax.net.ssl.trustStore="+mavenTrustStorePath+
+		" -Djavax.net.ssl.trustStorePassword="+mavenTrustStorePassword, result[0].Value)
+	assert.Equal(t, corev1.EnvVar{Name: mavenMirrorURLBuildArg, Value: "https://nexus.example.com/repository/maven-public/"}, result[1])
+
+	// OpenShift builds can't mount the local repository
+	result = withMavenBuildArgs(buildArgs, newTestMavenBuildConfig(), false)
+	assert.Equal(t, "-DmyProperty=true -gs "+mavenMirrorSettingsPath+" -s /maven/settings/settings.xml "+
+		"-Djavax.net.ssl.trustStore="+mavenTrustStorePath+" -Djavax.net.ssl.trustStorePassword="+mavenTrustStorePassword, result[0].Value)
+
+	maven := newTestMavenBuildConfig()
+	maven.MirrorURL = "https://nexus.example.com/repository/maven-public/?a=1&b=2"
+	result = withMavenBuildArgs(nil, maven, true)
+	assert.Equal(t, corev1.EnvVar{Name: mavenMirrorURLBuildArg, Value: "https://nexus.example.com/repository/maven-public/?a=1&amp;b=2"}, result[0])
+}
+
+func Test_withMavenBuildArgsNoConfig(t *testing.T) {
+	assert.Empty(t, withMavenBuildArgs(nil, nil, true))
+	result := withMavenBuildArgs(nil, &operatorapi.MavenBuildConfig{LocalRepository: "maven-repo"}, true)
+	assert.Equal(t, []corev1.EnvVar{{Name: mavenArgsAppendBuildArg, Value: "-Dmaven.repo.local=/maven/repository"}}, result)
+}
+
+func Test_mavenVolumes(t *testing.T) {
+	volumes, mounts := mavenVolumes(newTestMavenBuildConfig())
+	assert.Len(t, volumes, 3)
+	assert.Len(t, mounts, 3)
+	assert.Equal(t, "maven-settings", volumes[0].Secret.SecretName)
+	assert.Equal(t, []corev1.KeyToPath{{Key: "settings.xml", Path: mavenSettingsFileName}}, volumes[0].Secret.Items)
+	assert.Equal(t, "maven-repo", volumes[1].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, "nexus-ca", volumes[2].ConfigMap.Name)
+	assert.Equal(t, []corev1.KeyToPath{{Key: "ca-bundle.crt", Path: mavenCABundleFileName}}, volumes[2].ConfigMap.Items)
+	assert.Equal(t, mavenRepositoryMountPath, mounts[1].MountPath)
+}
+
+func Test_mavenBuildVolumes(t *testing.T) {
+	volumes := mavenBuildVolumes(newTestMavenBuildConfig())
+	// the local repository can't be mounted in OpenShift builds
+	assert.Len(t, volumes, 2)
+	assert.Equal(t, buildv1.BuildVolumeSourceTypeSecret, volumes[0].Source.Type)
+	assert.Equal(t, mavenSettingsMountPath, volumes[0].Mounts[0].DestinationPath)
+	assert.Equal(t, buildv1.BuildVolumeSourceTypeConfigMap, volumes[1].Source.Type)
+	assert.Equal(t, mavenCABundleMountPath, volumes[1].Mounts[0].DestinationPath)
+}
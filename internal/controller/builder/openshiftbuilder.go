@@ -22,6 +22,7 @@ package builder
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/openshift"
 
@@ -34,6 +35,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/platform"
@@ -45,6 +47,7 @@ import (
 	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
 
 	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/log"
 )
 
 const (
@@ -113,8 +116,11 @@ func (o *openshiftBuilderManager) Schedule(build *operatorapi.SonataFlowBuild) e
 	if err != nil {
 		return err
 	}
-	bc := o.newDefaultBuildConfig(build, workflow)
-	if err = o.addExternalResources(bc, workflow); err != nil {
+	bc, err := o.newDefaultBuildConfig(build, workflow)
+	if err != nil {
+		return err
+	}
+	if err = o.addExternalResources(bc, build, workflow); err != nil {
 		return err
 	}
 	workflowproj.SetMergedLabels(workflow, is)
@@ -137,9 +143,12 @@ func (o *openshiftBuilderManager) Schedule(build *operatorapi.SonataFlowBuild) e
 		if kubeutil.IsObjectNew(bc) {
 			return nil
 		}
-		referenceBC := o.newDefaultBuildConfig(build, workflow)
+		referenceBC, err := o.newDefaultBuildConfig(build, workflow)
+		if err != nil {
+			return err
+		}
 		bc.Spec = *referenceBC.Spec.DeepCopy()
-		return o.addExternalResources(bc, workflow)
+		return o.addExternalResources(bc, build, workflow)
 	}); err != nil {
 		return err
 	}
@@ -148,11 +157,15 @@ func (o *openshiftBuilderManager) Schedule(build *operatorapi.SonataFlowBuild) e
 	return nil
 }
 
-func (o *openshiftBuilderManager) newDefaultBuildConfig(build *operatorapi.SonataFlowBuild, workflow *operatorapi.SonataFlow) *buildv1.BuildConfig {
+func (o *openshiftBuilderManager) newDefaultBuildConfig(build *operatorapi.SonataFlowBuild, workflow *operatorapi.SonataFlow) (*buildv1.BuildConfig, error) {
 	optimizationPol := buildv1.ImageOptimizationSkipLayers
-	dockerFile := platform.GetCustomizedBuilderDockerfile(o.builderConfigMap.Data[defaultBuilderResourceName], *o.platform)
+	dockerFile, err := getBuilderDockerfile(o.builderConfigMap, build)
+	if err != nil {
+		return nil, err
+	}
+	dockerFile = platform.GetCustomizedBuilderDockerfile(dockerFile, *o.platform)
 	forcePull := kubeutil.GetImageTag(platform.GetFromImageTagDockerfile(dockerFile)) == "latest"
-	return &buildv1.BuildConfig{
+	bc := &buildv1.BuildConfig{
 		ObjectMeta: metav1.ObjectMeta{Namespace: build.Namespace, Name: build.Name},
 		Spec: buildv1.BuildConfigSpec{
 			RunPolicy:                    buildv1.BuildRunPolicySerial,
@@ -167,9 +180,10 @@ func (o *openshiftBuilderManager) newDefaultBuildConfig(build *operatorapi.Sonat
 					Type: buildv1.DockerBuildStrategyType,
 					DockerStrategy: &buildv1.DockerBuildStrategy{
 						ImageOptimizationPolicy: &optimizationPol,
-						BuildArgs:               build.Spec.BuildArgs,
+						BuildArgs:               withMavenBuildArgs(build.Spec.BuildArgs, o.platform.Spec.Build.Config.Maven, false),
 						Env:                     build.Spec.Envs,
 						ForcePull:               forcePull,
+						Volumes:                 mavenBuildVolumes(o.platform.Spec.Build.Config.Maven),
 					},
 				},
 				Output: buildv1.BuildOutput{
@@ -183,9 +197,26 @@ func (o *openshiftBuilderManager) newDefaultBuildConfig(build *operatorapi.Sonat
 			},
 		},
 	}
+	// OpenShift builds only support the node selector and service account scheduling options
+	if podTemplate := o.platform.Spec.Build.Config.GetBuilderPodTemplate(&build.Spec.BuildTemplate); podTemplate != nil {
+		bc.Spec.NodeSelector = podTemplate.NodeSelector
+		bc.Spec.ServiceAccount = podTemplate.ServiceAccountName
+	}
+	if build.Spec.Git != nil {
+		bc.Spec.Source.Type = buildv1.BuildSourceGit
+		bc.Spec.Source.Git = &buildv1.GitBuildSource{URI: build.Spec.Git.URL, Ref: build.Spec.Git.Revision}
+		bc.Spec.Source.ContextDir = build.Spec.Git.ContextDir
+		bc.Spec.Source.SourceSecret = build.Spec.Git.SecretRef
+	}
+	return bc, nil
 }
 
-func (o *openshiftBuilderManager) addExternalResources(config *buildv1.BuildConfig, workflow *operatorapi.SonataFlow) error {
+func (o *openshiftBuilderManager) addExternalResources(config *buildv1.BuildConfig, build *operatorapi.SonataFlowBuild, workflow *operatorapi.SonataFlow) error {
+	// the project cloned from Git already carries the workflow definitions and their resources
+	if build.Spec.Git != nil {
+		config.Spec.Source.ConfigMaps = nil
+		return nil
+	}
 	var configMapSources []buildv1.ConfigMapBuildSource
 	for _, workflowRes := range workflow.Spec.Resources.ConfigMaps {
 		configMapSources = append(configMapSources, buildv1.ConfigMapBuildSource{
@@ -250,11 +281,45 @@ func (o *openshiftBuilderManager) Reconcile(build *operatorapi.SonataFlowBuild)
 	if openshiftBuild.Status.Phase == buildv1.BuildPhaseError {
 		build.Status.Error = openshiftBuild.Status.Message
 	}
+	o.updateRetryStatus(build, openshiftBuild)
 	build.Status.ImageTag = openshiftBuild.Status.OutputDockerImageReference
+	if openshiftBuild.Spec.Revision != nil && openshiftBuild.Spec.Revision.Git != nil {
+		build.Status.GitCommit = openshiftBuild.Spec.Revision.Git.Commit
+	}
 
 	return build.Status.SetInnerBuild(kubeutil.ToTypedLocalReference(openshiftBuild))
 }
 
+// updateRetryStatus schedules a new OpenShift build for failed builds according to the platform retry policy.
+// Once the retry time is reached, the build moves back to the initialization phase to push a new OpenShift build.
+func (o *openshiftBuilderManager) updateRetryStatus(build *operatorapi.SonataFlowBuild, openshiftBuild *buildv1.Build) {
+	build.Status.NextRetryAt = nil
+	if build.Status.BuildPhase != operatorapi.BuildPhaseFailed && build.Status.BuildPhase != operatorapi.BuildPhaseError {
+		return
+	}
+	lastAttempt := time.Now()
+	if openshiftBuild.Status.CompletionTimestamp != nil {
+		lastAttempt = openshiftBuild.Status.CompletionTimestamp.Time
+	}
+	reason := openshiftBuild.Status.Message
+	if len(reason) == 0 {
+		reason = string(openshiftBuild.Status.Reason)
+	}
+	policy := toContainerBuilderRetryPolicy(o.platform.Spec.Build.Config.RetryPolicy)
+	next := nextRetry(policy, build.Status.RetryAttempts, lastAttempt, reason)
+	if next == nil {
+		return
+	}
+	build.Status.BuildPhase = operatorapi.BuildPhaseFailed
+	if next.After(time.Now()) {
+		build.Status.NextRetryAt = next
+		return
+	}
+	build.Status.RetryAttempts++
+	build.Status.BuildPhase = operatorapi.BuildPhaseInitialization
+	klog.V(log.I).InfoS("Retrying failed build", "build", build.Name, "attempt", build.Status.RetryAttempts, "attemptMax", policy.MaxAttempts)
+}
+
 func (o *openshiftBuilderManager) fetchOpenShiftBuildRef(build *operatorapi.SonataFlowBuild) (*buildv1.Build, error) {
 	openshiftBuild := &buildv1.Build{}
 	refOpenShiftBuild := &corev1.TypedLocalObjectReference{}
@@ -273,6 +338,14 @@ func (o *openshiftBuilderManager) fetchOpenShiftBuildRef(build *operatorapi.Sona
 // TODO: this should be from fileS, in this case we can TAR everything in a temp directory within the operator pod fs and push
 // TODO: for now, we mount the CMs from the devmode into the build and push only the bytes for the workflow definition from memory
 func (o *openshiftBuilderManager) pushNewOpenShiftBuildForWorkflow(build *operatorapi.SonataFlowBuild, workflow *operatorapi.SonataFlow) (*buildv1.Build, error) {
+	if build.Spec.Git != nil {
+		// the sources are cloned by the OpenShift build itself, no binary to push
+		request := &buildv1.BuildRequest{
+			ObjectMeta:  metav1.ObjectMeta{Name: build.Name, Namespace: build.Namespace},
+			TriggeredBy: []buildv1.BuildTriggerCause{{Message: defaultBuildMessageTrigger}},
+		}
+		return o.buildClient.BuildConfigs(build.Namespace).Instantiate(o.ctx, build.Name, request, metav1.CreateOptions{})
+	}
 	options := &buildv1.BinaryBuildRequestOptions{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: build.Name, Namespace: build.Namespace,
@@ -22,6 +22,7 @@ package builder
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/platform"
 	buildv1 "github.com/openshift/api/build/v1"
@@ -35,6 +36,7 @@ import (
 	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/workflowdef"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/test"
+	kubeutil "github.com/apache/incubator-kie-kogito-serverless-operator/utils/kubernetes"
 )
 
 func Test_openshiftBuilderManager_Reconcile(t *testing.T) {
@@ -175,3 +177,147 @@ func Test_openshiftbuilder_forcePull(t *testing.T) {
 	// verify if we set force pull to BC
 	assert.True(t, bc.Spec.Strategy.DockerStrategy.ForcePull)
 }
+
+func Test_openshiftbuilder_gitSource(t *testing.T) {
+	ns := t.Name()
+	workflow := test.GetBaseSonataFlow(ns)
+	workflow.Spec.Git = &operatorapi.GitSource{
+		URL:        "https://github.com/my-org/my-workflow.git",
+		Revision:   "main",
+		ContextDir: "greeting",
+		SecretRef:  &v1.LocalObjectReference{Name: "git-credentials"},
+	}
+	pl := test.GetBasePlatformInReadyPhase(t.Name())
+	config := test.GetSonataFlowBuilderConfig(ns)
+
+	namespacedName := types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name}
+	client := test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(workflow, pl, config).Build()
+	buildClient := buildfake.NewSimpleClientset().BuildV1()
+	managerContext := buildManagerContext{
+		ctx:              context.TODO(),
+		client:           client,
+		platform:         pl,
+		builderConfigMap: config,
+	}
+
+	buildManager := newOpenShiftBuilderManagerWithClient(managerContext, buildClient)
+
+	kogitoBuildManager := NewSonataFlowBuildManager(context.TODO(), client)
+	kbuild, err := kogitoBuildManager.GetOrCreateBuild(workflow)
+	assert.NoError(t, err)
+	assert.NoError(t, buildManager.Schedule(kbuild))
+
+	bc := &buildv1.BuildConfig{}
+	assert.NoError(t, client.Get(context.TODO(), namespacedName, bc))
+	assert.Equal(t, buildv1.BuildSourceGit, bc.Spec.Source.Type)
+	assert.Equal(t, "https://github.com/my-org/my-workflow.git", bc.Spec.Source.Git.URI)
+	assert.Equal(t, "main", bc.Spec.Source.Git.Ref)
+	assert.Equal(t, "greeting", bc.Spec.Source.ContextDir)
+	assert.Equal(t, "git-credentials", bc.Spec.Source.SourceSecret.Name)
+	assert.Empty(t, bc.Spec.Source.ConfigMaps)
+	assert.Equal(t, config.Data[projectBuilderResourceName], *bc.Spec.Source.Dockerfile)
+
+	ocpBuild := &buildv1.Build{
+		ObjectMeta: metav1.ObjectMeta{Name: namespacedName.Name, Namespace: namespacedName.Namespace},
+		Spec: buildv1.BuildSpec{CommonSpec: buildv1.CommonSpec{
+			Revision: &buildv1.SourceRevision{Git: &buildv1.GitSourceRevision{Commit: "0a1b2c3d"}},
+		}},
+		Status: buildv1.BuildStatus{Phase: buildv1.BuildPhaseComplete},
+	}
+	assert.NoError(t, client.Create(context.TODO(), ocpBuild))
+	kbuild.Status.BuildPhase = operatorapi.BuildPhaseRunning
+	assert.NoError(t, kbuild.Status.SetInnerBuild(kubeutil.ToTypedLocalReference(ocpBuild)))
+	assert.NoError(t, buildManager.Reconcile(kbuild))
+	assert.Equal(t, operatorapi.BuildPhaseSucceeded, kbuild.Status.BuildPhase)
+	assert.Equal(t, "0a1b2c3d", kbuild.Status.GitCommit)
+}
+
+func Test_openshiftbuilder_podTemplate(t *testing.T) {
+	ns := t.Name()
+	workflow := test.GetBaseSonataFlow(ns)
+	pl := test.GetBasePlatformInReadyPhase(t.Name())
+	pl.Spec.Build.Config.PodTemplate = &operatorapi.BuilderPodTemplateSpec{
+		NodeSelector:       map[string]string{"node-role.kubernetes.io/build": ""},
+		ServiceAccountName: "platform-builder",
+	}
+	pl.Spec.Build.Template.PodTemplate = &operatorapi.BuilderPodTemplateSpec{ServiceAccountName: "workflow-builder"}
+	config := test.GetSonataFlowBuilderConfig(ns)
+
+	namespacedName := types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name}
+	client := test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(workflow, pl, config).Build()
+	managerContext := buildManagerContext{
+		ctx:              context.TODO(),
+		client:           client,
+		platform:         pl,
+		builderConfigMap: config,
+	}
+	buildManager := newOpenShiftBuilderManagerWithClient(managerContext, buildfake.NewSimpleClientset().BuildV1())
+
+	kbuild, err := NewSonataFlowBuildManager(context.TODO(), client).GetOrCreateBuild(workflow)
+	assert.NoError(t, err)
+	assert.NoError(t, buildManager.Schedule(kbuild))
+
+	bc := &buildv1.BuildConfig{}
+	assert.NoError(t, client.Get(context.TODO(), namespacedName, bc))
+	assert.Equal(t, buildv1.OptionalNodeSelector{"node-role.kubernetes.io/build": ""}, bc.Spec.NodeSelector)
+	// the build template takes precedence over the platform configuration
+	assert.Equal(t, "workflow-builder", bc.Spec.ServiceAccount)
+}
+
+func Test_openshiftbuilder_retryFailedBuild(t *testing.T) {
+	ns := t.Name()
+	workflow := test.GetBaseSonataFlow(ns)
+	pl := test.GetBasePlatformInReadyPhase(t.Name())
+	pl.Spec.Build.Config.RetryPolicy = &operatorapi.BuildRetryPolicy{
+		MaxAttempts:      1,
+		InitialBackoff:   &metav1.Duration{Duration: time.Hour},
+		RetryableReasons: []string{"(?i)timeout"},
+	}
+	config := test.GetSonataFlowBuilderConfig(ns)
+	client := test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(workflow, pl, config).Build()
+	managerContext := buildManagerContext{
+		ctx:              context.TODO(),
+		client:           client,
+		platform:         pl,
+		builderConfigMap: config,
+	}
+	buildManager := newOpenShiftBuilderManagerWithClient(managerContext, buildfake.NewSimpleClientset().BuildV1())
+
+	kbuild, err := NewSonataFlowBuildManager(context.TODO(), client).GetOrCreateBuild(workflow)
+	assert.NoError(t, err)
+	completion := metav1.Now()
+	ocpBuild := &buildv1.Build{
+		ObjectMeta: metav1.ObjectMeta{Name: workflow.Name, Namespace: ns},
+		Status: buildv1.BuildStatus{
+			Phase:               buildv1.BuildPhaseFailed,
+			Message:             "Connection timeout while fetching artifacts",
+			CompletionTimestamp: &completion,
+		},
+	}
+	assert.NoError(t, client.Create(context.TODO(), ocpBuild))
+	kbuild.Status.BuildPhase = operatorapi.BuildPhaseRunning
+	assert.NoError(t, kbuild.Status.SetInnerBuild(kubeutil.ToTypedLocalReference(ocpBuild)))
+
+	// retry scheduled after the initial backoff
+	assert.NoError(t, buildManager.Reconcile(kbuild))
+	assert.Equal(t, operatorapi.BuildPhaseFailed, kbuild.Status.BuildPhase)
+	assert.NotNil(t, kbuild.Status.NextRetryAt)
+	assert.Equal(t, int32(0), kbuild.Status.RetryAttempts)
+
+	// backoff elapsed, the build starts over
+	past := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	ocpBuild.Status.CompletionTimestamp = &past
+	assert.NoError(t, client.Delete(context.TODO(), ocpBuild))
+	ocpBuild.ResourceVersion = ""
+	assert.NoError(t, client.Create(context.TODO(), ocpBuild))
+	assert.NoError(t, buildManager.Reconcile(kbuild))
+	assert.Equal(t, operatorapi.BuildPhaseInitialization, kbuild.Status.BuildPhase)
+	assert.Nil(t, kbuild.Status.NextRetryAt)
+	assert.Equal(t, int32(1), kbuild.Status.RetryAttempts)
+
+	// attempts exhausted
+	kbuild.Status.BuildPhase = operatorapi.BuildPhaseRunning
+	assert.NoError(t, buildManager.Reconcile(kbuild))
+	assert.Equal(t, operatorapi.BuildPhaseFailed, kbuild.Status.BuildPhase)
+	assert.Nil(t, kbuild.Status.NextRetryAt)
+}
@@ -0,0 +1,65 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package builder
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/container-builder/api"
+	builder "github.com/apache/incubator-kie-kogito-serverless-operator/container-builder/builder/kubernetes"
+)
+
+// toContainerBuilderRetryPolicy converts the platform retry policy to the container-builder one.
+// Without a platform retry policy, failed builds are not retried.
+func toContainerBuilderRetryPolicy(policy *operatorapi.BuildRetryPolicy) *api.RetryPolicy {
+	result := builder.DefaultRetryPolicy
+	result.MaxAttempts = 0
+	if policy == nil {
+		return &result
+	}
+	result.MaxAttempts = int(policy.MaxAttempts)
+	if policy.InitialBackoff != nil {
+		result.MinBackoff = *policy.InitialBackoff
+	}
+	if policy.MaxBackoff != nil {
+		result.MaxBackoff = *policy.MaxBackoff
+	}
+	if policy.BackoffMultiplier != nil {
+		result.Factor = float64(*policy.BackoffMultiplier)
+	}
+	result.RetryableReasons = policy.RetryableReasons
+	return &result
+}
+
+// nextRetry returns when a failed build must be retried according to the given policy.
+// Returns nil if the attempts are exhausted or the failure reason is not retryable.
+func nextRetry(policy *api.RetryPolicy, attempts int32, lastAttempt time.Time, reason string) *metav1.Time {
+	if policy == nil {
+		policy = &builder.DefaultRetryPolicy
+	}
+	if int(attempts) >= policy.MaxAttempts || !builder.IsRetryableFailure(policy, reason) {
+		return nil
+	}
+	next := metav1.NewTime(lastAttempt.Add(builder.RetryBackoff(policy, int(attempts))))
+	return &next
+}
@@ -0,0 +1,188 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package certmanager
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/magiconair/properties"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/rest"
+
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
+	kubeutil "github.com/apache/incubator-kie-kogito-serverless-operator/utils/kubernetes"
+)
+
+const (
+	certManagerGroup = "cert-manager.io"
+	// HTTPSPortName is the name of the container and Service ports serving HTTPS.
+	HTTPSPortName = "https"
+	// HTTPSPort is the container port serving HTTPS.
+	HTTPSPort int32 = 8443
+	// HTTPSServicePort is the Service port serving HTTPS.
+	HTTPSServicePort int32 = 443
+	// TLSMountPath is the directory the certificate Secret is mounted into.
+	TLSMountPath = "/deployments/tls"
+
+	tlsVolumeName     = "tls"
+	tlsSecretSuffix   = "-tls"
+	defaultIssuerKind = "Issuer"
+
+	quarkusHTTPSSLPort             = "quarkus.http.ssl-port"
+	quarkusHTTPSSLCertificateFiles = "quarkus.http.ssl.certificate.files"
+	quarkusHTTPSSLKeyFiles         = "quarkus.http.ssl.certificate.key-files"
+)
+
+// CertificateGVK is the cert-manager Certificate kind. The object is handled as unstructured to not depend on the cert-manager API.
+var CertificateGVK = schema.GroupVersionKind{Group: certManagerGroup, Version: "v1", Kind: "Certificate"}
+
+var available = false
+
+// IsAvailable is a global flag that can be safely called across reconciliation cycles, defined at the controller manager start.
+// It returns true if cert-manager is installed in the cluster.
+func IsAvailable() bool {
+	return available
+}
+
+// SetAvailable sets the global flag telling whether cert-manager is installed in the cluster by the controller manager.
+func SetAvailable(cfg *rest.Config) error {
+	avail, err := GetCertManagerAvailability(cfg)
+	if err != nil {
+		return err
+	}
+	available = avail
+	return nil
+}
+
+// GetCertManagerAvailability returns true if cert-manager is installed in the cluster.
+func GetCertManagerAvailability(cfg *rest.Config) (bool, error) {
+	cli, err := utils.GetDiscoveryClient(cfg)
+	if err != nil {
+		return false, err
+	}
+	apiList, err := cli.ServerGroups()
+	if err != nil {
+		return false, err
+	}
+	for _, group := range apiList.Groups {
+		if group.Name == certManagerGroup {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// PlatformUsesTLS returns true if the services of the given platform are served over HTTPS.
+// The certificate Secrets are issued by cert-manager, without it the services keep serving HTTP only.
+func PlatformUsesTLS(platform *operatorapi.SonataFlowPlatform) bool {
+	return platform.IsTLSEnabled() && available
+}
+
+// UsesTLS returns true if the given workflow is served over HTTPS. Workflows deployed with Knative Serving, run as a Job, or in dev profile, are not.
+func UsesTLS(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) bool {
+	return PlatformUsesTLS(platform) && !profiles.IsDevProfile(workflow) && workflow.IsKubernetesDeployment()
+}
+
+// TLSSecretName returns the name of the Secret holding the certificate of the given workflow or service.
+func TLSSecretName(name string) string {
+	return name + tlsSecretSuffix
+}
+
+// NewCertificate creates an empty Certificate with the given name and namespace.
+func NewCertificate(name, namespace string) *unstructured.Unstructured {
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(CertificateGVK)
+	certificate.SetName(name)
+	certificate.SetNamespace(namespace)
+	return certificate
+}
+
+// SetCertificateSpec overrides the Certificate spec to request a certificate valid for the in-cluster names of the given Service.
+func SetCertificateSpec(certificate *unstructured.Unstructured, tls *operatorapi.PlatformTLSSpec, serviceName, namespace string) error {
+	kind := tls.IssuerRef.Kind
+	if len(kind) == 0 {
+		kind = defaultIssuerKind
+	}
+	spec := map[string]interface{}{
+		"secretName": TLSSecretName(serviceName),
+		"dnsNames": []interface{}{
+			serviceName,
+			fmt.Sprintf("%s.%s", serviceName, namespace),
+			fmt.Sprintf("%s.%s.svc", serviceName, namespace),
+			fmt.Sprintf("%s.%s.svc.cluster.local", serviceName, namespace),
+		},
+		"issuerRef": map[string]interface{}{
+			"name":  tls.IssuerRef.Name,
+			"kind":  kind,
+			"group": certManagerGroup,
+		},
+	}
+	if tls.Duration != nil {
+		spec["duration"] = tls.Duration.Duration.String()
+	}
+	return unstructured.SetNestedField(certificate.Object, spec, "spec")
+}
+
+// ConfigurePodTLS mounts the certificate Secret of the given workflow or service into the container and opens its HTTPS port.
+func ConfigurePodTLS(podSpec *corev1.PodSpec, container *corev1.Container, name string) {
+	kubeutil.AddOrReplaceVolume(podSpec, corev1.Volume{
+		Name: tlsVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: TLSSecretName(name)},
+		},
+	})
+	kubeutil.AddOrReplaceVolumeMount(container, kubeutil.VolumeMount(tlsVolumeName, true, TLSMountPath))
+	for _, port := range container.Ports {
+		if port.Name == HTTPSPortName {
+			return
+		}
+	}
+	container.Ports = append(container.Ports, corev1.ContainerPort{Name: HTTPSPortName, ContainerPort: HTTPSPort, Protocol: corev1.ProtocolTCP})
+}
+
+// AddHTTPSServicePort adds the HTTPS port to the given Service, if not present.
+func AddHTTPSServicePort(service *corev1.Service) {
+	if port, _ := kubeutil.GetServicePortByName(HTTPSPortName, service); port != nil {
+		return
+	}
+	service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+		Name:       HTTPSPortName,
+		Protocol:   corev1.ProtocolTCP,
+		Port:       HTTPSServicePort,
+		TargetPort: intstr.FromInt32(HTTPSPort),
+	})
+}
+
+// GetTLSProperties returns the set of application properties serving HTTPS with the mounted certificate.
+// The HTTP port keeps serving the requests, so that the health probes and the in-cluster callbacks keep working.
+func GetTLSProperties() *properties.Properties {
+	props := properties.NewProperties()
+	props.Set(quarkusHTTPSSLPort, fmt.Sprintf("%d", HTTPSPort))
+	props.Set(quarkusHTTPSSLCertificateFiles, path.Join(TLSMountPath, corev1.TLSCertKey))
+	props.Set(quarkusHTTPSSLKeyFiles, path.Join(TLSMountPath, corev1.TLSPrivateKeyKey))
+	props.Sort()
+	return props
+}
@@ -41,6 +41,8 @@ var defaultControllersCfg = &ControllersCfg{
 	DefaultPvcKanikoSize:          "1Gi",
 	KanikoDefaultWarmerImageTag:   "gcr.io/kaniko-project/warmer:v1.9.0",
 	KanikoExecutorImageTag:        "gcr.io/kaniko-project/executor:v1.9.0",
+	GitCloneImageTag:              "docker.io/alpine/git:2.45.2",
+	WorkflowTriggerImageTag:       "docker.io/curlimages/curl:8.10.1",
 	BuilderConfigMapName:          "sonataflow-operator-builder-config",
 }
 
@@ -63,6 +65,8 @@ type ControllersCfg struct {
 	HealthFailureThresholdDevMode   int32  `yaml:"healthFailureThresholdDevMode,omitempty"`
 	KanikoDefaultWarmerImageTag     string `yaml:"kanikoDefaultWarmerImageTag,omitempty"`
 	KanikoExecutorImageTag          string `yaml:"kanikoExecutorImageTag,omitempty"`
+	GitCloneImageTag                string `yaml:"gitCloneImageTag,omitempty"`
+	WorkflowTriggerImageTag         string `yaml:"workflowTriggerImageTag,omitempty"`
 	JobsServicePostgreSQLImageTag   string `yaml:"jobsServicePostgreSQLImageTag,omitempty"`
 	JobsServiceEphemeralImageTag    string `yaml:"jobsServiceEphemeralImageTag,omitempty"`
 	DataIndexPostgreSQLImageTag     string `yaml:"dataIndexPostgreSQLImageTag,omitempty"`
@@ -71,6 +75,9 @@ type ControllersCfg struct {
 	SonataFlowDevModeImageTag       string `yaml:"sonataFlowDevModeImageTag,omitempty"`
 	BuilderConfigMapName            string `yaml:"builderConfigMapName,omitempty"`
 	PostgreSQLPersistenceExtensions []GAV  `yaml:"postgreSQLPersistenceExtensions,omitempty"`
+	OIDCExtensions                  []GAV  `yaml:"oidcExtensions,omitempty"`
+	OIDCClientExtensions            []GAV  `yaml:"oidcClientExtensions,omitempty"`
+	KafkaEventingExtensions         []GAV  `yaml:"kafkaEventingExtensions,omitempty"`
 	KogitoEventsGrouping            bool   `yaml:"kogitoEventsGrouping,omitempty"`
 	KogitoEventsGroupingBinary      bool   `yaml:"KogitoEventsGroupingBinary,omitempty"`
 	KogitoEventsGroupingCompress    bool   `yaml:"KogitoEventsGroupingCompress,omitempty"`
@@ -54,6 +54,21 @@ func TestInitializeControllersCfgAt_ValidFile(t *testing.T) {
 		ArtifactId: "kie-addons-quarkus-persistence-jdbc",
 		Version:    "999-SNAPSHOT",
 	}, postgresExtensions[2])
+	assert.Equal(t, []GAV{{
+		GroupId:    "io.quarkus",
+		ArtifactId: "quarkus-oidc",
+		Version:    "3.8.6",
+	}}, cfg.OIDCExtensions)
+	assert.Equal(t, []GAV{{
+		GroupId:    "io.quarkus",
+		ArtifactId: "quarkus-oidc-client",
+		Version:    "3.8.6",
+	}}, cfg.OIDCClientExtensions)
+	assert.Equal(t, []GAV{{
+		GroupId:    "io.quarkus",
+		ArtifactId: "quarkus-smallrye-reactive-messaging-kafka",
+		Version:    "3.8.6",
+	}}, cfg.KafkaEventingExtensions)
 	assert.True(t, cfg.KogitoEventsGrouping)
 	assert.True(t, cfg.KogitoEventsGroupingBinary)
 	assert.False(t, cfg.KogitoEventsGroupingCompress)
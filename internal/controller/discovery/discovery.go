@@ -33,6 +33,7 @@ const (
 	KnativeScheme    = "knative"
 	KubernetesScheme = "kubernetes"
 	OpenshiftScheme  = "openshift"
+	GatewayScheme    = "gateway"
 
 	// PortQueryParam well known query param to select a particular target port, for example when a service is being
 	// discovered and there are many ports to select.
@@ -59,6 +60,9 @@ const (
 	// openshift groups
 	openshiftRoutes            = "openshift:routes.v1.route.openshift.io"
 	openshiftDeploymentConfigs = "openshift:deploymentconfigs.v1.apps.openshift.io"
+
+	// gateway api groups
+	gatewayHTTPRoutes = "gateway:httproutes.v1.gateway.networking.k8s.io"
 )
 
 type ResourceUri struct {
@@ -84,14 +88,16 @@ type sonataFlowServiceCatalog struct {
 	kubernetesCatalog ServiceCatalog
 	knativeCatalog    ServiceCatalog
 	openshiftCatalog  ServiceCatalog
+	gatewayCatalog    ServiceCatalog
 }
 
-// NewServiceCatalog returns a new ServiceCatalog configured to resolve kubernetes, knative, and openshift resource addresses.
+// NewServiceCatalog returns a new ServiceCatalog configured to resolve kubernetes, knative, openshift, and gateway resource addresses.
 func NewServiceCatalog(cli client.Client, knDiscoveryClient *KnDiscoveryClient, openShiftDiscoveryClient *OpenShiftDiscoveryClient) ServiceCatalog {
 	return &sonataFlowServiceCatalog{
 		kubernetesCatalog: newK8SServiceCatalog(cli),
 		knativeCatalog:    newKnServiceCatalog(knDiscoveryClient),
 		openshiftCatalog:  newOpenShiftServiceCatalog(openShiftDiscoveryClient),
+		gatewayCatalog:    newGatewayServiceCatalog(cli),
 	}
 }
 
@@ -100,6 +106,7 @@ func NewServiceCatalogForConfig(cli client.Client, cfg *rest.Config) ServiceCata
 		kubernetesCatalog: newK8SServiceCatalog(cli),
 		knativeCatalog:    newKnServiceCatalogForConfig(cfg),
 		openshiftCatalog:  newOpenShiftServiceCatalogForClientAndConfig(cli, cfg),
+		gatewayCatalog:    newGatewayServiceCatalog(cli),
 	}
 }
 
@@ -111,6 +118,8 @@ func (c *sonataFlowServiceCatalog) Query(ctx context.Context, uri ResourceUri, o
 		return c.knativeCatalog.Query(ctx, uri, outputFormat)
 	case OpenshiftScheme:
 		return c.openshiftCatalog.Query(ctx, uri, outputFormat)
+	case GatewayScheme:
+		return c.gatewayCatalog.Query(ctx, uri, outputFormat)
 	default:
 		return "", fmt.Errorf("unknown scheme was provided for service discovery: %s", uri.Scheme)
 	}
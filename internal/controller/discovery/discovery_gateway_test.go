@@ -0,0 +1,97 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package discovery
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func Test_QueryGatewayHTTPRoute(t *testing.T) {
+	doTestQueryGatewayHTTPRoute(t, gwapi.HTTPProtocolType, 80, "http://httproutehost1/orders")
+}
+
+func Test_QueryGatewayHTTPRouteWithTLS(t *testing.T) {
+	doTestQueryGatewayHTTPRoute(t, gwapi.HTTPSProtocolType, 8443, "https://httproutehost1:8443/orders")
+}
+
+func doTestQueryGatewayHTTPRoute(t *testing.T, protocol gwapi.ProtocolType, port gwapi.PortNumber, expectedUri string) {
+	s := runtime.NewScheme()
+	utilruntime.Must(gwapi.Install(s))
+	gateway := &gwapi.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace1,
+			Name:      "gateway1",
+		},
+		Spec: gwapi.GatewaySpec{
+			Listeners: []gwapi.Listener{{Name: "web", Protocol: protocol, Port: port}},
+		},
+	}
+	pathPrefix := gwapi.PathMatchPathPrefix
+	path := "/orders"
+	route := &gwapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace1,
+			Name:      "httpRoute1",
+		},
+		Spec: gwapi.HTTPRouteSpec{
+			CommonRouteSpec: gwapi.CommonRouteSpec{ParentRefs: []gwapi.ParentReference{{Name: "gateway1"}}},
+			Hostnames:       []gwapi.Hostname{"httproutehost1"},
+			Rules: []gwapi.HTTPRouteRule{{
+				Matches: []gwapi.HTTPRouteMatch{{Path: &gwapi.HTTPPathMatch{Type: &pathPrefix, Value: &path}}},
+			}},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(gateway, route).Build()
+	ctg := NewServiceCatalog(cli, nil, nil)
+	doTestQuery(t, ctg, *NewResourceUriBuilder(GatewayScheme).
+		Kind("httproutes").
+		Group("gateway.networking.k8s.io").
+		Version("v1").
+		Namespace(namespace1).
+		Name("httpRoute1").Build(), "", expectedUri)
+}
+
+func Test_QueryGatewayHTTPRouteWithoutGateway(t *testing.T) {
+	s := runtime.NewScheme()
+	utilruntime.Must(gwapi.Install(s))
+	route := &gwapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace1,
+			Name:      "httpRoute1",
+		},
+		Spec: gwapi.HTTPRouteSpec{
+			CommonRouteSpec: gwapi.CommonRouteSpec{ParentRefs: []gwapi.ParentReference{{Name: "gateway1"}}},
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(route).Build()
+	ctg := NewServiceCatalog(cli, nil, nil)
+	doTestQueryWithError(t, ctg, *NewResourceUriBuilder(GatewayScheme).
+		Kind("httproutes").
+		Group("gateway.networking.k8s.io").
+		Version("v1").
+		Namespace(namespace1).
+		Name("httpRoute1").Build(), "", "\"gateway1\" not found")
+}
@@ -0,0 +1,66 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package discovery
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/gateway"
+)
+
+const (
+	httpRouteKind = "httproutes"
+)
+
+type gatewayServiceCatalog struct {
+	Client client.Client
+}
+
+func newGatewayServiceCatalog(cli client.Client) gatewayServiceCatalog {
+	return gatewayServiceCatalog{
+		Client: cli,
+	}
+}
+
+func (c gatewayServiceCatalog) Query(ctx context.Context, uri ResourceUri, outputFormat string) (string, error) {
+	switch uri.GVK.Kind {
+	case httpRouteKind:
+		return c.resolveHTTPRouteQuery(ctx, uri)
+	default:
+		return "", fmt.Errorf("resolution of gateway kind: %s is not implemented", uri.GVK.Kind)
+	}
+}
+
+func (c gatewayServiceCatalog) resolveHTTPRouteQuery(ctx context.Context, uri ResourceUri) (string, error) {
+	route := &gwapi.HTTPRoute{}
+	if err := c.Client.Get(ctx, types.NamespacedName{Namespace: uri.Namespace, Name: uri.Name}, route); err != nil {
+		return "", err
+	}
+	url, err := gateway.ResolveHTTPRouteURL(ctx, c.Client, route)
+	if err != nil {
+		return "", err
+	}
+	return url.String(), nil
+}
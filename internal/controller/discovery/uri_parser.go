@@ -49,12 +49,15 @@ const (
 
 	openshiftGroupsPattern = "^(" + openshiftDeploymentConfigs +
 		"|" + openshiftRoutes + ")"
+
+	gatewayGroupsPattern = "^(" + gatewayHTTPRoutes + ")"
 )
 
 var kubernetesGroupsExpr = regexp.MustCompile(kubernetesGroupsPattern)
 var knativeGroupsExpr = regexp.MustCompile(knativeGroupsPattern)
 var knativeSimplifiedServiceExpr = regexp.MustCompile(knativeSimplifiedServicePatten)
 var openshiftGroupsExpr = regexp.MustCompile(openshiftGroupsPattern)
+var gatewayGroupsExpr = regexp.MustCompile(gatewayGroupsPattern)
 var namespaceAndNameExpr = regexp.MustCompile(namespaceAndNamePattern)
 var queryStringExpr = regexp.MustCompile(queryStringPattern)
 
@@ -67,8 +70,10 @@ func ParseUri(uri string) (*ResourceUri, error) {
 		return parseKnativeSimplifiedServiceUri(uri)
 	} else if split := openshiftGroupsExpr.Split(uri, -1); len(split) == 2 {
 		return parseOpenshiftUri(uri, openshiftGroupsExpr.FindString(uri), split[1])
+	} else if split := gatewayGroupsExpr.Split(uri, -1); len(split) == 2 {
+		return parseGatewayUri(uri, gatewayGroupsExpr.FindString(uri), split[1])
 	}
-	return nil, fmt.Errorf("invalid uri: %s, not correspond to any of the available schemes format: %s, %s, %s", uri, KubernetesScheme, KnativeScheme, OpenshiftScheme)
+	return nil, fmt.Errorf("invalid uri: %s, not correspond to any of the available schemes format: %s, %s, %s, %s", uri, KubernetesScheme, KnativeScheme, OpenshiftScheme, GatewayScheme)
 }
 
 func parseKubernetesUri(uri string, schemaAndGroup string, after string) (*ResourceUri, error) {
@@ -180,6 +185,12 @@ func parseGVK(schemaGvk string) (*v1.GroupVersionKind, error) {
 			Version: "v1",
 			Kind:    "deploymentconfigs",
 		}, nil
+	case gatewayHTTPRoutes:
+		return &v1.GroupVersionKind{
+			Group:   "gateway.networking.k8s.io",
+			Version: "v1",
+			Kind:    "httproutes",
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown schema and gvk: %s", schemaGvk)
 	}
@@ -240,3 +251,17 @@ func parseOpenshiftUri(uri string, schemaAndGroup string, after string) (*Resour
 		}, nil
 	}
 }
+
+func parseGatewayUri(uri string, schemaAndGroup string, after string) (*ResourceUri, error) {
+	if namespace, name, gvk, queryParams, err := parseNamespaceNameGVKAndQueryParams(uri, schemaAndGroup, after); err != nil {
+		return nil, err
+	} else {
+		return &ResourceUri{
+			Scheme:      GatewayScheme,
+			GVK:         *gvk,
+			Namespace:   namespace,
+			Name:        name,
+			QueryParams: queryParams,
+		}, nil
+	}
+}
@@ -251,6 +251,27 @@ var OpenshiftRoutesTestValues = map[string]*ResourceUri{
 		Build(),
 }
 
+var GatewayHTTPRoutesTestValues = map[string]*ResourceUri{
+	"gateway:httproutes.v1.gateway.networking.k8s.io": nil,
+
+	"gateway:httproutes.v1.gateway.networking.k8s.io/my-route": NewResourceUriBuilder(GatewayScheme).
+		Kind("httproutes").
+		Group("gateway.networking.k8s.io").
+		Version("v1").
+		Name("my-route").
+		Build(),
+
+	"gateway:httproutes.v1.gateway.networking.k8s.io/my-namespace/my-route": NewResourceUriBuilder(GatewayScheme).
+		Kind("httproutes").
+		Group("gateway.networking.k8s.io").
+		Version("v1").
+		Namespace("my-namespace").
+		Name("my-route").
+		Build(),
+
+	"gateway:gateways.v1.gateway.networking.k8s.io/my-gateway": nil,
+}
+
 var OpenshiftDeploymentConfigsTestValues = map[string]*ResourceUri{
 	"openshift:deploymentconfigs.v1.apps.openshift.io": nil,
 
@@ -300,6 +321,12 @@ func TestParseOpenshiftDeploymentConfigsURI(t *testing.T) {
 	}
 }
 
+func TestParseGatewayHTTPRoutesURI(t *testing.T) {
+	for k, v := range GatewayHTTPRoutesTestValues {
+		doTestParseURI(t, k, v)
+	}
+}
+
 func doTestParseURI(t *testing.T, url string, expectedUri *ResourceUri) {
 	result, err := ParseUri(url)
 	if expectedUri == nil {
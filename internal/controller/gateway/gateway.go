@@ -0,0 +1,149 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"knative.dev/pkg/apis"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1"
+
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
+)
+
+const (
+	gatewayGroup = "gateway.networking.k8s.io"
+)
+
+// GetGatewayAPIAvailability returns true if the Gateway API is installed in the cluster.
+func GetGatewayAPIAvailability(cfg *rest.Config) (bool, error) {
+	cli, err := utils.GetDiscoveryClient(cfg)
+	if err != nil {
+		return false, err
+	}
+	apiList, err := cli.ServerGroups()
+	if err != nil {
+		return false, err
+	}
+	for _, group := range apiList.Groups {
+		if group.Name == gatewayGroup {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// SetHTTPRouteSpec overrides the HTTPRoute spec with the one defined by the given route, forwarding the traffic to the service port.
+func SetHTTPRouteSpec(route *gwapi.HTTPRoute, spec *operatorapi.HTTPRouteSpec, serviceName string, servicePort int32) {
+	parentRef := gwapi.ParentReference{Name: gwapi.ObjectName(spec.GatewayRef.Name)}
+	if len(spec.GatewayRef.Namespace) > 0 {
+		namespace := gwapi.Namespace(spec.GatewayRef.Namespace)
+		parentRef.Namespace = &namespace
+	}
+	if len(spec.GatewayRef.SectionName) > 0 {
+		sectionName := gwapi.SectionName(spec.GatewayRef.SectionName)
+		parentRef.SectionName = &sectionName
+	}
+	var hostnames []gwapi.Hostname
+	for _, hostname := range spec.Hostnames {
+		hostnames = append(hostnames, gwapi.Hostname(hostname))
+	}
+	pathType := gwapi.PathMatchPathPrefix
+	path := spec.GetPath()
+	port := gwapi.PortNumber(servicePort)
+	route.Spec = gwapi.HTTPRouteSpec{
+		CommonRouteSpec: gwapi.CommonRouteSpec{ParentRefs: []gwapi.ParentReference{parentRef}},
+		Hostnames:       hostnames,
+		Rules: []gwapi.HTTPRouteRule{{
+			Matches: []gwapi.HTTPRouteMatch{{
+				Path: &gwapi.HTTPPathMatch{Type: &pathType, Value: &path},
+			}},
+			BackendRefs: []gwapi.HTTPBackendRef{{
+				BackendRef: gwapi.BackendRef{
+					BackendObjectReference: gwapi.BackendObjectReference{
+						Name: gwapi.ObjectName(serviceName),
+						Port: &port,
+					},
+				},
+			}},
+		}},
+	}
+}
+
+// ResolveHTTPRouteURL gets the external URL of the given HTTPRoute based on its hostnames and the listener of the Gateway it's attached to.
+func ResolveHTTPRouteURL(ctx context.Context, cli client.Client, route *gwapi.HTTPRoute) (*apis.URL, error) {
+	if len(route.Spec.ParentRefs) == 0 {
+		return nil, fmt.Errorf("HTTPRoute %s/%s is not attached to any Gateway", route.Namespace, route.Name)
+	}
+	parentRef := route.Spec.ParentRefs[0]
+	namespace := route.Namespace
+	if parentRef.Namespace != nil {
+		namespace = string(*parentRef.Namespace)
+	}
+	gateway := &gwapi.Gateway{}
+	if err := cli.Get(ctx, types.NamespacedName{Namespace: namespace, Name: string(parentRef.Name)}, gateway); err != nil {
+		return nil, err
+	}
+	listener := findListener(gateway, parentRef.SectionName)
+	if listener == nil {
+		return nil, fmt.Errorf("no HTTP listener found in Gateway %s/%s for HTTPRoute %s/%s", gateway.Namespace, gateway.Name, route.Namespace, route.Name)
+	}
+
+	var host string
+	if len(route.Spec.Hostnames) > 0 {
+		host = string(route.Spec.Hostnames[0])
+	} else if listener.Hostname != nil {
+		host = string(*listener.Hostname)
+	} else if len(gateway.Status.Addresses) > 0 {
+		host = gateway.Status.Addresses[0].Value
+	} else {
+		return nil, fmt.Errorf("no hostname or address found for HTTPRoute %s/%s", route.Namespace, route.Name)
+	}
+	url := &apis.URL{Scheme: "http", Host: host}
+	if listener.Protocol == gwapi.HTTPSProtocolType {
+		url.Scheme = "https"
+		if listener.Port != 443 {
+			url.Host = fmt.Sprintf("%s:%d", host, listener.Port)
+		}
+	} else if listener.Port != 80 {
+		url.Host = fmt.Sprintf("%s:%d", host, listener.Port)
+	}
+	if len(route.Spec.Rules) > 0 && len(route.Spec.Rules[0].Matches) > 0 && route.Spec.Rules[0].Matches[0].Path != nil && route.Spec.Rules[0].Matches[0].Path.Value != nil {
+		url.Path = *route.Spec.Rules[0].Matches[0].Path.Value
+	}
+	return url, nil
+}
+
+func findListener(gateway *gwapi.Gateway, sectionName *gwapi.SectionName) *gwapi.Listener {
+	for i, listener := range gateway.Spec.Listeners {
+		if listener.Protocol != gwapi.HTTPProtocolType && listener.Protocol != gwapi.HTTPSProtocolType {
+			continue
+		}
+		if sectionName == nil || listener.Name == *sectionName {
+			return &gateway.Spec.Listeners[i]
+		}
+	}
+	return nil
+}
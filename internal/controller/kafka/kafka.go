@@ -0,0 +1,193 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kafka
+
+import (
+	"strings"
+
+	"github.com/magiconair/properties"
+	corev1 "k8s.io/api/core/v1"
+
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/cfg"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/knative"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
+)
+
+const (
+	SmallRyeKafkaConnector = "smallrye-kafka"
+
+	BootstrapServers           = "kafka.bootstrap.servers"
+	SecurityProtocol           = "kafka.security.protocol"
+	SASLMechanism              = "kafka.sasl.mechanism"
+	SASLJAASConfig             = "kafka.sasl.jaas.config"
+	SSLTruststoreType          = "kafka.ssl.truststore.type"
+	SSLTruststoreCertificates  = "kafka.ssl.truststore.certificates"
+	StringSerializer           = "org.apache.kafka.common.serialization.StringSerializer"
+	StringDeserializer         = "org.apache.kafka.common.serialization.StringDeserializer"
+	ByteArrayDeserializer      = "org.apache.kafka.common.serialization.ByteArrayDeserializer"
+	pemTruststoreType          = "PEM"
+	saslJAASConfigEnv          = "KAFKA_SASL_JAAS_CONFIG"
+	sslTruststoreCertsEnv      = "KAFKA_SSL_TRUSTSTORE_CERTIFICATES"
+	ConnectorAttribute         = "connector"
+	TopicAttribute             = "topic"
+	groupIDAttribute           = "group.id"
+	valueSerializerAttribute   = "value.serializer"
+	valueDeserializerAttribute = "value.deserializer"
+)
+
+// GetPlatformKafka returns the Kafka eventing configuration of the given platform, nil if there's none.
+func GetPlatformKafka(pl *operatorapi.SonataFlowPlatform) *operatorapi.PlatformKafkaEventingSpec {
+	if pl == nil || pl.Spec.Eventing == nil || pl.Spec.Eventing.Broker != nil {
+		return nil
+	}
+	return pl.Spec.Eventing.Kafka
+}
+
+// GetWorkflowKafkaPlatform returns the platform holding the Kafka eventing configuration used by the workflow, either the
+// given platform or the one referred by its SonataFlowClusterPlatform.
+// Nil if the workflow runs in the dev profile, has a Knative sink, or no Kafka is configured.
+func GetWorkflowKafkaPlatform(workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform) (*operatorapi.SonataFlowPlatform, error) {
+	if workflow == nil || pl == nil || profiles.IsDevProfile(workflow) {
+		return nil, nil
+	}
+	sink, err := knative.GetWorkflowSink(workflow, pl)
+	if err != nil || sink != nil {
+		return nil, err
+	}
+	if GetPlatformKafka(pl) != nil {
+		return pl, nil
+	}
+	remote, err := knative.GetRemotePlatform(pl)
+	if err != nil {
+		return nil, err
+	}
+	if GetPlatformKafka(remote) != nil {
+		return remote, nil
+	}
+	return nil, nil
+}
+
+// GetKafkaExtensions returns the Quarkus extensions required to produce and consume events from Kafka.
+func GetKafkaExtensions() []cfg.GAV {
+	return cfg.GetCfg().KafkaEventingExtensions
+}
+
+// TopicName returns the Kafka topic of the given event type or channel according to the platform topic naming strategy.
+func TopicName(pl *operatorapi.SonataFlowPlatform, eventType string) string {
+	if GetPlatformKafka(pl).GetTopicNaming() == operatorapi.NamespacePrefixedKafkaTopicNaming {
+		return pl.Namespace + "." + eventType
+	}
+	return eventType
+}
+
+// ConsumerGroup returns the Kafka consumer group of the given workflow. The namespace is included since the topics might
+// be shared by the workflows of different namespaces, and namespace names can't contain dots.
+func ConsumerGroup(workflow *operatorapi.SonataFlow) string {
+	return workflow.Namespace + "." + workflow.Name
+}
+
+// GetBootstrapServers returns the "host:port" addresses of the Kafka brokers of the given configuration, nil if there's none.
+func GetBootstrapServers(kafka *operatorapi.PlatformKafkaEventingSpec) []string {
+	if kafka == nil {
+		return nil
+	}
+	var servers []string
+	for _, server := range strings.Split(kafka.BootstrapServers, ",") {
+		if server = strings.TrimSpace(server); len(server) > 0 {
+			servers = append(servers, server)
+		}
+	}
+	return servers
+}
+
+// GetClientProperties returns the set of application properties connecting the Kafka clients to the brokers.
+// Never nil.
+func GetClientProperties(kafka *operatorapi.PlatformKafkaEventingSpec) *properties.Properties {
+	props := properties.NewProperties()
+	if kafka == nil {
+		return props
+	}
+	props.Set(BootstrapServers, kafka.BootstrapServers)
+	if kafka.Security != nil {
+		if len(kafka.Security.Protocol) > 0 {
+			props.Set(SecurityProtocol, string(kafka.Security.Protocol))
+		}
+		if len(kafka.Security.SASLMechanism) > 0 {
+			props.Set(SASLMechanism, kafka.Security.SASLMechanism)
+		}
+		if kafka.Security.SASLJAASConfigRef != nil {
+			props.Set(SASLJAASConfig, "${"+saslJAASConfigEnv+"}")
+		}
+		if kafka.Security.CACertificateRef != nil {
+			props.Set(SSLTruststoreType, pemTruststoreType)
+			props.Set(SSLTruststoreCertificates, "${"+sslTruststoreCertsEnv+"}")
+		}
+	}
+	props.Sort()
+	return props
+}
+
+// GetIncomingChannelProperties returns the set of application properties consuming the CloudEvents of the given channel
+// from the given topic. The group ID is skipped when empty.
+// Never nil.
+func GetIncomingChannelProperties(channel, topic, groupID string) *properties.Properties {
+	props := properties.NewProperties()
+	props.Set(utils.MessagingChannelProperty(utils.IncomingChannel, channel, ConnectorAttribute), SmallRyeKafkaConnector)
+	props.Set(utils.MessagingChannelProperty(utils.IncomingChannel, channel, TopicAttribute), topic)
+	props.Set(utils.MessagingChannelProperty(utils.IncomingChannel, channel, valueDeserializerAttribute), ByteArrayDeserializer)
+	if len(groupID) > 0 {
+		props.Set(utils.MessagingChannelProperty(utils.IncomingChannel, channel, groupIDAttribute), groupID)
+	}
+	return props
+}
+
+// GetOutgoingChannelProperties returns the set of application properties producing the CloudEvents of the given channel
+// to the given topic.
+// Never nil.
+func GetOutgoingChannelProperties(channel, topic string) *properties.Properties {
+	props := properties.NewProperties()
+	props.Set(utils.MessagingChannelProperty(utils.OutgoingChannel, channel, ConnectorAttribute), SmallRyeKafkaConnector)
+	props.Set(utils.MessagingChannelProperty(utils.OutgoingChannel, channel, TopicAttribute), topic)
+	props.Set(utils.MessagingChannelProperty(utils.OutgoingChannel, channel, valueSerializerAttribute), StringSerializer)
+	return props
+}
+
+// ConfigureEnv returns the env variables holding the Kafka client secrets, if any.
+func ConfigureEnv(kafka *operatorapi.PlatformKafkaEventingSpec) []corev1.EnvVar {
+	if kafka == nil || kafka.Security == nil {
+		return nil
+	}
+	var env []corev1.EnvVar
+	if kafka.Security.SASLJAASConfigRef != nil {
+		env = append(env, corev1.EnvVar{
+			Name:      saslJAASConfigEnv,
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: kafka.Security.SASLJAASConfigRef.DeepCopy()},
+		})
+	}
+	if kafka.Security.CACertificateRef != nil {
+		env = append(env, corev1.EnvVar{
+			Name:      sslTruststoreCertsEnv,
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: kafka.Security.CACertificateRef.DeepCopy()},
+		})
+	}
+	return env
+}
@@ -0,0 +1,92 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kafka
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/test"
+)
+
+func newKafkaPlatform() *operatorapi.SonataFlowPlatform {
+	platform := test.GetBasePlatform()
+	platform.Spec.Eventing = &operatorapi.PlatformEventingSpec{
+		Kafka: &operatorapi.PlatformKafkaEventingSpec{BootstrapServers: "my-cluster-kafka-bootstrap.kafka:9092"},
+	}
+	return platform
+}
+
+func Test_GetWorkflowKafkaPlatform(t *testing.T) {
+	platform := newKafkaPlatform()
+
+	workflow := test.GetBaseSonataFlowWithProdProfile(platform.Namespace)
+	kafkaPlatform, err := GetWorkflowKafkaPlatform(workflow, platform)
+	assert.NoError(t, err)
+	assert.Equal(t, platform, kafkaPlatform)
+
+	devWorkflow := test.GetBaseSonataFlowWithDevProfile(platform.Namespace)
+	kafkaPlatform, err = GetWorkflowKafkaPlatform(devWorkflow, platform)
+	assert.NoError(t, err)
+	assert.Nil(t, kafkaPlatform)
+
+	workflow.Spec.Sink = &duckv1.Destination{Ref: &duckv1.KReference{Name: "default", Kind: "Broker", APIVersion: "eventing.knative.dev/v1"}}
+	kafkaPlatform, err = GetWorkflowKafkaPlatform(workflow, platform)
+	assert.NoError(t, err)
+	assert.Nil(t, kafkaPlatform)
+
+	kafkaPlatform, err = GetWorkflowKafkaPlatform(workflow, test.GetBasePlatform())
+	assert.NoError(t, err)
+	assert.Nil(t, kafkaPlatform)
+}
+
+func Test_TopicName(t *testing.T) {
+	platform := newKafkaPlatform()
+	assert.Equal(t, "org.acme.order.placed", TopicName(platform, "org.acme.order.placed"))
+	platform.Spec.Eventing.Kafka.TopicNaming = operatorapi.NamespacePrefixedKafkaTopicNaming
+	assert.Equal(t, platform.Namespace+".org.acme.order.placed", TopicName(platform, "org.acme.order.placed"))
+}
+
+func Test_ClientPropertiesAndEnv(t *testing.T) {
+	kafka := newKafkaPlatform().Spec.Eventing.Kafka
+	assert.Equal(t, map[string]string{BootstrapServers: kafka.BootstrapServers}, GetClientProperties(kafka).Map())
+	assert.Empty(t, ConfigureEnv(kafka))
+
+	kafka.Security = &operatorapi.KafkaSecuritySpec{
+		Protocol:         operatorapi.KafkaSSLSecurityProtocol,
+		CACertificateRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "my-cluster-cluster-ca-cert"}, Key: "ca.crt"},
+	}
+	props := GetClientProperties(kafka)
+	props.DisableExpansion = true
+	assert.Equal(t, "SSL", props.GetString(SecurityProtocol, ""))
+	assert.Equal(t, "PEM", props.GetString(SSLTruststoreType, ""))
+	assert.Equal(t, "${KAFKA_SSL_TRUSTSTORE_CERTIFICATES}", props.GetString(SSLTruststoreCertificates, ""))
+	_, found := props.Get(SASLJAASConfig)
+	assert.False(t, found)
+
+	env := ConfigureEnv(kafka)
+	assert.Len(t, env, 1)
+	assert.Equal(t, "KAFKA_SSL_TRUSTSTORE_CERTIFICATES", env[0].Name)
+	assert.Equal(t, kafka.Security.CACertificateRef, env[0].ValueFrom.SecretKeyRef)
+}
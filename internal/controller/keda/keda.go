@@ -0,0 +1,121 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package keda
+
+import (
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
+)
+
+const (
+	kedaGroup        = "keda.sh"
+	kafkaTriggerType = "kafka"
+	// knativeKafkaBrokerTopicPrefix and knativeKafkaTriggerGroupPrefix follow the naming of the resources created by the Knative Kafka Broker.
+	knativeKafkaBrokerTopicPrefix  = "knative-broker"
+	knativeKafkaTriggerGroupPrefix = "knative-trigger"
+)
+
+// ScaledObjectGVK is the KEDA ScaledObject kind. The object is handled as unstructured to not depend on the KEDA API.
+var ScaledObjectGVK = schema.GroupVersionKind{Group: kedaGroup, Version: "v1alpha1", Kind: "ScaledObject"}
+
+// KafkaTrigger is the Kafka topic and consumer group whose lag scales a workflow.
+type KafkaTrigger struct {
+	Topic         string
+	ConsumerGroup string
+}
+
+// GetKedaAvailability returns true if KEDA is installed in the cluster.
+func GetKedaAvailability(cfg *rest.Config) (bool, error) {
+	cli, err := utils.GetDiscoveryClient(cfg)
+	if err != nil {
+		return false, err
+	}
+	apiList, err := cli.ServerGroups()
+	if err != nil {
+		return false, err
+	}
+	for _, group := range apiList.Groups {
+		if group.Name == kedaGroup {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// NewScaledObject creates an empty ScaledObject with the given name and namespace.
+func NewScaledObject(name, namespace string) *unstructured.Unstructured {
+	scaledObject := &unstructured.Unstructured{}
+	scaledObject.SetGroupVersionKind(ScaledObjectGVK)
+	scaledObject.SetName(name)
+	scaledObject.SetNamespace(namespace)
+	return scaledObject
+}
+
+// KnativeKafkaBrokerTrigger returns the topic and consumer group used by a Knative Kafka Broker to dispatch the events of the given trigger.
+func KnativeKafkaBrokerTrigger(brokerNamespace, brokerName, triggerNamespace, triggerName string) KafkaTrigger {
+	return KafkaTrigger{
+		Topic:         fmt.Sprintf("%s-%s-%s", knativeKafkaBrokerTopicPrefix, brokerNamespace, brokerName),
+		ConsumerGroup: fmt.Sprintf("%s-%s-%s", knativeKafkaTriggerGroupPrefix, triggerNamespace, triggerName),
+	}
+}
+
+// SetScaledObjectSpec overrides the ScaledObject spec to scale the given Deployment on the lag of the given Kafka triggers.
+func SetScaledObjectSpec(scaledObject *unstructured.Unstructured, deploymentName string, autoscaling *operatorapi.EventDrivenAutoscalingSpec, triggers []KafkaTrigger) error {
+	spec := map[string]interface{}{
+		"scaleTargetRef": map[string]interface{}{
+			"name": deploymentName,
+		},
+		"minReplicaCount": int64(autoscaling.GetMinReplicas()),
+		"maxReplicaCount": int64(autoscaling.GetMaxReplicas()),
+	}
+	if autoscaling.PollingInterval != nil {
+		spec["pollingInterval"] = int64(*autoscaling.PollingInterval)
+	}
+	if autoscaling.CooldownPeriod != nil {
+		spec["cooldownPeriod"] = int64(*autoscaling.CooldownPeriod)
+	}
+	var kedaTriggers []interface{}
+	for _, trigger := range triggers {
+		kedaTrigger := map[string]interface{}{
+			"type": kafkaTriggerType,
+			"metadata": map[string]interface{}{
+				"bootstrapServers": autoscaling.Kafka.BootstrapServers,
+				"consumerGroup":    trigger.ConsumerGroup,
+				"topic":            trigger.Topic,
+				"lagThreshold":     strconv.FormatInt(autoscaling.Kafka.GetLagThreshold(), 10),
+			},
+		}
+		if len(autoscaling.Kafka.AuthenticationRef) > 0 {
+			kedaTrigger["authenticationRef"] = map[string]interface{}{
+				"name": autoscaling.Kafka.AuthenticationRef,
+			}
+		}
+		kedaTriggers = append(kedaTriggers, kedaTrigger)
+	}
+	spec["triggers"] = kedaTriggers
+	return unstructured.SetNestedField(scaledObject.Object, spec, "spec")
+}
@@ -29,9 +29,13 @@ import (
 	"github.com/apache/incubator-kie-kogito-serverless-operator/workflowproj"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
+	"knative.dev/eventing/pkg/apis/eventing"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	eventingv1beta2 "knative.dev/eventing/pkg/apis/eventing/v1beta2"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 	clienteventingv1 "knative.dev/eventing/pkg/client/clientset/versioned/typed/eventing/v1"
 	"knative.dev/pkg/apis"
@@ -58,6 +62,10 @@ const (
 	knativeEventingAPIVersion = "eventing.knative.dev/v1"
 	knativeBrokerKind         = "Broker"
 	knativeSinkProvided       = "SinkProvided"
+	// KafkaBrokerClass is the class of the Knative Kafka Broker, storing its events in a Kafka topic.
+	KafkaBrokerClass = "Kafka"
+	// EventingNamespace is the namespace where the Knative Eventing data plane, delivering the events to and from the workflows, runs.
+	EventingNamespace = "knative-eventing"
 )
 
 func GetKnativeServingClient(cfg *rest.Config) (clientservingv1.ServingV1Interface, error) {
@@ -111,9 +119,9 @@ func GetKnativeAvailability(cfg *rest.Config) (*Availability, error) {
 	}
 }
 
-// getRemotePlatform returns the remote platfrom referred by a SonataFlowClusterPlatform
-func getRemotePlatform(pl *operatorapi.SonataFlowPlatform) (*operatorapi.SonataFlowPlatform, error) {
-	if pl.Status.ClusterPlatformRef != nil {
+// GetRemotePlatform returns the remote platform referred by a SonataFlowClusterPlatform
+func GetRemotePlatform(pl *operatorapi.SonataFlowPlatform) (*operatorapi.SonataFlowPlatform, error) {
+	if pl != nil && pl.Status.ClusterPlatformRef != nil {
 		// Find the platform referred by the cluster platform
 		platform := &operatorapi.SonataFlowPlatform{}
 		if err := utils.GetClient().Get(context.TODO(), types.NamespacedName{Namespace: pl.Status.ClusterPlatformRef.PlatformRef.Namespace, Name: pl.Status.ClusterPlatformRef.PlatformRef.Name}, platform); err != nil {
@@ -158,7 +166,7 @@ func GetWorkflowSink(workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlo
 		return getDestinationWithNamespace(pl.Spec.Eventing.Broker, pl.Namespace), nil
 	}
 	// Find the remote platform referred by the cluster platform
-	platform, err := getRemotePlatform(pl)
+	platform, err := GetRemotePlatform(pl)
 	if err != nil {
 		return nil, err
 	}
@@ -172,6 +180,70 @@ func IsKnativeBroker(kRef *duckv1.KReference) bool {
 	return kRef.APIVersion == knativeEventingAPIVersion && kRef.Kind == knativeBrokerKind
 }
 
+// IsKafkaBroker returns true if the referred Knative Broker is a Knative Kafka Broker, according to its class annotation.
+func IsKafkaBroker(kRef *duckv1.KReference) (bool, error) {
+	broker := &eventingv1.Broker{}
+	if err := utils.GetClient().Get(context.TODO(), types.NamespacedName{Name: kRef.Name, Namespace: kRef.Namespace}, broker); err != nil {
+		return false, err
+	}
+	return broker.Annotations[eventing.BrokerClassKey] == KafkaBrokerClass, nil
+}
+
+// NewBrokerReference returns the reference to the given Knative Broker.
+func NewBrokerReference(name, namespace string) *duckv1.KReference {
+	return &duckv1.KReference{APIVersion: knativeEventingAPIVersion, Kind: knativeBrokerKind, Name: name, Namespace: namespace}
+}
+
+// NewEventType creates an EventType describing the events of the given type available in the given broker, so that they're
+// listed in the Knative Eventing catalog. The source and schema are optional.
+func NewEventType(name string, labels map[string]string, broker *duckv1.KReference, eventType, source, schema, description string) (*eventingv1beta2.EventType, error) {
+	et := &eventingv1beta2.EventType{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: broker.Namespace,
+			Labels:    labels,
+		},
+		Spec: eventingv1beta2.EventTypeSpec{
+			Type:        eventType,
+			Reference:   broker.DeepCopy(),
+			Description: description,
+		},
+	}
+	if len(source) > 0 {
+		url, err := apis.ParseURL(source)
+		if err != nil {
+			return nil, fmt.Errorf("invalid source %s of the event type %s: %v", source, eventType, err)
+		}
+		et.Spec.Source = url
+	}
+	if len(schema) > 0 {
+		url, err := apis.ParseURL(schema)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schema %s of the event type %s: %v", schema, eventType, err)
+		}
+		et.Spec.Schema = url
+	}
+	return et, nil
+}
+
+// DeleteEventTypes deletes the EventTypes matching the given labels in every namespace.
+// EventTypes created in a broker namespace other than the one of their owner can't be garbage collected.
+func DeleteEventTypes(ctx context.Context, c client.Client, labels map[string]string) error {
+	eventTypes := &eventingv1beta2.EventTypeList{}
+	if err := c.List(ctx, eventTypes, client.MatchingLabels(labels)); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return err
+	}
+	for i := range eventTypes.Items {
+		if err := c.Delete(ctx, &eventTypes.Items[i]); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 func SaveKnativeData(dest *corev1.PodSpec, source *corev1.PodSpec) {
 	for _, volume := range source.Volumes {
 		if volume.Name == knativeBundleVolume {
@@ -27,6 +27,9 @@ import (
 
 	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/container-builder/client"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/certmanager"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/gateway"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/kafka"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/knative"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/platform/services"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/constants"
@@ -38,10 +41,14 @@ import (
 	"github.com/imdario/mergo"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	eventingv1beta2 "knative.dev/eventing/pkg/apis/eventing/v1beta2"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 // NewServiceAction returns an action that deploys the services.
@@ -94,6 +101,18 @@ func createOrUpdateServiceComponents(ctx context.Context, client client.Client,
 	if err := createOrUpdateService(ctx, client, platform, psh); err != nil {
 		return nil, err
 	}
+	if err := createOrUpdateCertificate(ctx, client, platform, psh); err != nil {
+		return nil, err
+	}
+	if err := createOrUpdateIngress(ctx, client, platform, psh); err != nil {
+		return nil, err
+	}
+	if err := createOrUpdateHTTPRoute(ctx, client, platform, psh); err != nil {
+		return nil, err
+	}
+	if err := createOrUpdateNetworkPolicy(ctx, client, platform, psh); err != nil {
+		return nil, err
+	}
 	return createOrUpdateKnativeResources(ctx, client, platform, psh)
 }
 
@@ -185,6 +204,9 @@ func createOrUpdateDeployment(ctx context.Context, client client.Client, platfor
 	if err != nil {
 		return err
 	}
+	if certmanager.PlatformUsesTLS(platform) {
+		certmanager.ConfigurePodTLS(&serviceDeploymentSpec.Template.Spec, serviceContainer, psh.GetServiceName())
+	}
 	kubeutil.AddOrReplaceContainer(serviceContainer.Name, *serviceContainer, &serviceDeploymentSpec.Template.Spec)
 
 	serviceDeployment := &appsv1.Deployment{
@@ -242,7 +264,9 @@ func createOrUpdateService(ctx context.Context, client client.Client, platform *
 	// Create or Update the service
 	if op, err := controllerutil.CreateOrUpdate(ctx, client, dataSvc, func() error {
 		dataSvc.Spec = dataSvcSpec
-
+		if certmanager.PlatformUsesTLS(platform) {
+			certmanager.AddHTTPSServicePort(dataSvc)
+		}
 		return nil
 	}); err != nil {
 		return err
@@ -253,16 +277,168 @@ func createOrUpdateService(ctx context.Context, client client.Client, platform *
 	return nil
 }
 
-func getLabels(platform *operatorapi.SonataFlowPlatform, psh services.PlatformServiceHandler) (map[string]string, map[string]string) {
-	lbl := map[string]string{
+// createOrUpdateCertificate requests the certificate serving the service over HTTPS from cert-manager, if the platform configures TLS.
+func createOrUpdateCertificate(ctx context.Context, client client.Client, platform *operatorapi.SonataFlowPlatform, psh services.PlatformServiceHandler) error {
+	certificate := certmanager.NewCertificate(psh.GetServiceName(), platform.Namespace)
+	if !platform.IsTLSEnabled() {
+		return kubeutil.DeleteIfExists(ctx, client, certificate)
+	}
+	if avail, err := certmanager.GetCertManagerAvailability(client.GetConfig()); err != nil {
+		return err
+	} else if !avail {
+		klog.V(log.I).InfoS("cert-manager is not available in this cluster, skipping the Certificate", "service", psh.GetServiceName())
+		return nil
+	}
+	lbl, _ := getLabels(platform, psh)
+	if err := controllerutil.SetControllerReference(platform, certificate, client.Scheme()); err != nil {
+		return err
+	}
+
+	// Create or Update the Certificate
+	if op, err := controllerutil.CreateOrUpdate(ctx, client, certificate, func() error {
+		certificate.SetLabels(lbl)
+		return certmanager.SetCertificateSpec(certificate, platform.Spec.TLS, psh.GetServiceName(), platform.Namespace)
+	}); err != nil {
+		return err
+	} else {
+		klog.V(log.I).InfoS("Certificate successfully reconciled", "operation", op)
+	}
+	return nil
+}
+
+func createOrUpdateIngress(ctx context.Context, client client.Client, platform *operatorapi.SonataFlowPlatform, psh services.PlatformServiceHandler) error {
+	lbl, _ := getLabels(platform, psh)
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: platform.Namespace,
+			Name:      psh.GetServiceName(),
+			Labels:    lbl,
+		}}
+	exposure := psh.GetServiceExposure()
+	if exposure == nil {
+		psh.SetServiceEndpointInPlatformStatus("")
+		return kubeutil.DeleteIfExists(ctx, client, ingress)
+	}
+	if err := controllerutil.SetControllerReference(platform, ingress, client.Scheme()); err != nil {
+		return err
+	}
+
+	// Create or Update the ingress
+	if op, err := controllerutil.CreateOrUpdate(ctx, client, ingress, func() error {
+		kubeutil.SetIngressSpec(ingress, exposure, psh.GetServiceName(), 80)
+		return nil
+	}); err != nil {
+		return err
+	} else {
+		klog.V(log.I).InfoS("Ingress successfully reconciled", "operation", op)
+	}
+	psh.SetServiceEndpointInPlatformStatus(kubeutil.ExposureURL(exposure).String())
+	return nil
+}
+
+func createOrUpdateHTTPRoute(ctx context.Context, client client.Client, platform *operatorapi.SonataFlowPlatform, psh services.PlatformServiceHandler) error {
+	lbl, _ := getLabels(platform, psh)
+	route := &gwapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: platform.Namespace,
+			Name:      psh.GetServiceName(),
+			Labels:    lbl,
+		}}
+	routeSpec := psh.GetServiceHTTPRoute()
+	if routeSpec == nil {
+		return kubeutil.DeleteIfExists(ctx, client, route)
+	}
+	if avail, err := gateway.GetGatewayAPIAvailability(client.GetConfig()); err != nil {
+		return err
+	} else if !avail {
+		klog.V(log.I).InfoS("Gateway API is not available in this cluster, skipping the HTTPRoute", "service", psh.GetServiceName())
+		return nil
+	}
+	if err := controllerutil.SetControllerReference(platform, route, client.Scheme()); err != nil {
+		return err
+	}
+
+	// Create or Update the HTTPRoute
+	if op, err := controllerutil.CreateOrUpdate(ctx, client, route, func() error {
+		gateway.SetHTTPRouteSpec(route, routeSpec, psh.GetServiceName(), 80)
+		return nil
+	}); err != nil {
+		return err
+	} else {
+		klog.V(log.I).InfoS("HTTPRoute successfully reconciled", "operation", op)
+	}
+	if psh.GetServiceExposure() == nil {
+		url, err := gateway.ResolveHTTPRouteURL(ctx, client, route)
+		if err != nil {
+			klog.V(log.I).InfoS("Unable to resolve the service endpoint from the Gateway", "service", psh.GetServiceName(), "error", err)
+			return nil
+		}
+		psh.SetServiceEndpointInPlatformStatus(url.String())
+	}
+	return nil
+}
+
+// createOrUpdateNetworkPolicy allows only the traffic required by the service: the workflows and the other platform services,
+// the Knative Eventing data plane when the service is bound to a source, its persistence, and the platform Kafka brokers.
+func createOrUpdateNetworkPolicy(ctx context.Context, client client.Client, platform *operatorapi.SonataFlowPlatform, psh services.PlatformServiceHandler) error {
+	lbl, selectorLbl := getLabels(platform, psh)
+	policy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: platform.Namespace,
+			Name:      psh.GetServiceName(),
+			Labels:    lbl,
+		}}
+	if !platform.IsNetworkPoliciesEnabled() {
+		return kubeutil.DeleteIfExists(ctx, client, policy)
+	}
+	traffic := &kubeutil.NetworkPolicyTraffic{
+		PodSelector:        selectorLbl,
+		HTTPPort:           variables.DefaultHTTPWorkflowPortIntStr,
+		HTTPFromAnywhere:   psh.GetServiceExposure() != nil || psh.GetServiceHTTPRoute() != nil,
+		HTTPFromNamespaces: platform.Spec.NetworkPolicies.IngressNamespaces,
+		ToNamespaces:       []string{psh.GetPersistenceServiceNamespace()},
+		ToCIDRs:            platform.Spec.NetworkPolicies.EgressCIDRs,
+		ToServers:          kafka.GetBootstrapServers(kafka.GetPlatformKafka(platform)),
+	}
+	if certmanager.PlatformUsesTLS(platform) {
+		httpsPort := intstr.FromInt32(certmanager.HTTPSPort)
+		traffic.HTTPSPort = &httpsPort
+	}
+	if psh.GetServiceSource() != nil {
+		traffic.FromNamespaces = append(traffic.FromNamespaces, knative.EventingNamespace)
+		traffic.ToNamespaces = append(traffic.ToNamespaces, knative.EventingNamespace)
+	}
+	if err := controllerutil.SetControllerReference(platform, policy, client.Scheme()); err != nil {
+		return err
+	}
+
+	// Create or Update the NetworkPolicy
+	if op, err := controllerutil.CreateOrUpdate(ctx, client, policy, func() error {
+		kubeutil.SetNetworkPolicySpec(policy, traffic)
+		return nil
+	}); err != nil {
+		return err
+	} else {
+		klog.V(log.I).InfoS("NetworkPolicy successfully reconciled", "operation", op)
+	}
+	return nil
+}
+
+// GetServicesCommonLabels returns the labels shared by the resources of every platform service.
+func GetServicesCommonLabels(platform *operatorapi.SonataFlowPlatform) map[string]string {
+	return map[string]string{
 		workflowproj.LabelApp:          platform.Name,
 		workflowproj.LabelAppNamespace: platform.Namespace,
-		workflowproj.LabelService:      psh.GetServiceName(),
-		workflowproj.LabelK8SName:      psh.GetContainerName(),
-		workflowproj.LabelK8SComponent: psh.GetServiceName(),
-		workflowproj.LabelK8SPartOF:    platform.Name,
 		workflowproj.LabelK8SManagedBy: "sonataflow-operator",
 	}
+}
+
+func getLabels(platform *operatorapi.SonataFlowPlatform, psh services.PlatformServiceHandler) (map[string]string, map[string]string) {
+	lbl := GetServicesCommonLabels(platform)
+	lbl[workflowproj.LabelService] = psh.GetServiceName()
+	lbl[workflowproj.LabelK8SName] = psh.GetContainerName()
+	lbl[workflowproj.LabelK8SComponent] = psh.GetServiceName()
+	lbl[workflowproj.LabelK8SPartOF] = platform.Name
 	selectorLbl := map[string]string{
 		workflowproj.LabelService: psh.GetServiceName(),
 	}
@@ -317,7 +493,7 @@ func createOrUpdateKnativeResources(ctx context.Context, client client.Client, p
 	if err != nil {
 		return event, err
 	}
-	// Create or update triggers
+	// Create or update triggers and event types
 	for _, obj := range objs {
 		if triggerDef, ok := obj.(*eventingv1.Trigger); ok {
 			if platform.Namespace == obj.GetNamespace() {
@@ -343,6 +519,27 @@ func createOrUpdateKnativeResources(ctx context.Context, client client.Client, p
 			}
 			addToSonataFlowPlatformTriggerList(platform, trigger)
 		}
+		if eventTypeDef, ok := obj.(*eventingv1beta2.EventType); ok {
+			if platform.Namespace == obj.GetNamespace() {
+				if err := controllerutil.SetControllerReference(platform, obj, client.Scheme()); err != nil {
+					return nil, err
+				}
+			} else {
+				// EventTypes in a different namespace are cleaned up by the same finalizer as the triggers
+				if err := setSonataFlowPlatformFinalizer(ctx, client, platform); err != nil {
+					return nil, err
+				}
+			}
+			eventType := &eventingv1beta2.EventType{
+				ObjectMeta: eventTypeDef.ObjectMeta,
+			}
+			if _, err := controllerutil.CreateOrUpdate(ctx, client, eventType, func() error {
+				eventType.Spec = eventTypeDef.Spec
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	if err := SafeUpdatePlatformStatus(ctx, platform); err != nil {
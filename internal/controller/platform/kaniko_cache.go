@@ -127,6 +127,14 @@ func createKanikoCacheWarmerPod(ctx context.Context, client client.Client, platf
 			},
 		},
 	}
+	if podTemplate := platform.Spec.Build.Config.GetBuilderPodTemplate(nil); podTemplate != nil {
+		pod.Spec.NodeSelector = podTemplate.NodeSelector
+		pod.Spec.Tolerations = podTemplate.Tolerations
+		pod.Spec.Affinity = podTemplate.Affinity
+		pod.Spec.PriorityClassName = podTemplate.PriorityClassName
+		pod.Spec.ServiceAccountName = podTemplate.ServiceAccountName
+		pod.Spec.ImagePullSecrets = podTemplate.ImagePullSecrets
+	}
 
 	err := client.Delete(ctx, &pod)
 	if err != nil && !k8serrors.IsNotFound(err) {
@@ -29,6 +29,7 @@ import (
 	"k8s.io/klog/v2"
 
 	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/kafka"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/knative"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/constants"
@@ -167,6 +168,10 @@ func GenerateDataIndexWorkflowProperties(workflow *operatorapi.SonataFlow, platf
 	if err != nil {
 		return nil, err
 	}
+	kafkaPlatform, err := kafka.GetWorkflowKafkaPlatform(workflow, platform)
+	if err != nil {
+		return nil, err
+	}
 	di := NewDataIndexHandler(platform)
 	if !profiles.IsDevProfile(workflow) && workflow != nil && workflow.Status.Services != nil && workflow.Status.Services.DataIndexRef != nil {
 		serviceBaseUrl := workflow.Status.Services.DataIndexRef.Url
@@ -181,6 +186,10 @@ func GenerateDataIndexWorkflowProperties(workflow *operatorapi.SonataFlow, platf
 				props.Set(constants.KogitoProcessInstancesEventsURL, constants.KnativeInjectedEnvVar)
 				props.Set(constants.KogitoProcessDefinitionsEventsMethod, constants.Post)
 				props.Set(constants.KogitoProcessInstancesEventsMethod, constants.Post)
+			} else if kafkaPlatform != nil {
+				props.Set(constants.KogitoDataIndexURL, serviceBaseUrl)
+				props.Merge(kafka.GetOutgoingChannelProperties(constants.KogitoProcessDefinitionsEventsChannel, kafka.TopicName(kafkaPlatform, constants.KogitoProcessDefinitionsEventsChannel)))
+				props.Merge(kafka.GetOutgoingChannelProperties(constants.KogitoProcessInstancesEventsChannel, kafka.TopicName(kafkaPlatform, constants.KogitoProcessInstancesEventsChannel)))
 			} else {
 				props.Set(constants.KogitoDataIndexHealthCheckEnabled, "true")
 				props.Set(constants.KogitoDataIndexURL, serviceBaseUrl)
@@ -206,6 +215,10 @@ func GenerateJobServiceWorkflowProperties(workflow *operatorapi.SonataFlow, plat
 	if err != nil {
 		return nil, err
 	}
+	kafkaPlatform, err := kafka.GetWorkflowKafkaPlatform(workflow, platform)
+	if err != nil {
+		return nil, err
+	}
 	js := NewJobServiceHandler(platform)
 	if !profiles.IsDevProfile(workflow) && workflow != nil && workflow.Status.Services != nil && workflow.Status.Services.JobServiceRef != nil {
 		serviceBaseUrl := workflow.Status.Services.JobServiceRef.Url
@@ -214,6 +227,10 @@ func GenerateJobServiceWorkflowProperties(workflow *operatorapi.SonataFlow, plat
 				props.Set(constants.JobServiceRequestEventsURL, constants.KnativeInjectedEnvVar)
 				props.Set(constants.JobServiceRequestEventsConnector, constants.QuarkusHTTP)
 				props.Set(constants.JobServiceRequestEventsMethod, constants.Post)
+			} else if kafkaPlatform != nil {
+				props.Set(constants.KogitoJobServiceURL, serviceBaseUrl)
+				props.Delete(constants.JobServiceRequestEventsURL)
+				props.Merge(kafka.GetOutgoingChannelProperties(constants.JobServiceRequestEventsChannel, kafka.TopicName(kafkaPlatform, constants.JobServiceRequestEventsChannel)))
 			} else {
 				if workflowdef.HasTimeouts(workflow) {
 					props.Set(constants.KogitoJobServiceHealthCheckEnabled, "true")
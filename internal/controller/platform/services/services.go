@@ -24,7 +24,9 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/certmanager"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/cfg"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/kafka"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/knative"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/utils/kubernetes"
@@ -45,6 +47,8 @@ import (
 	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/constants"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/persistence"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/security"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/version"
 )
 
@@ -108,6 +112,15 @@ type PlatformServiceHandler interface {
 
 	GetServiceSource() *duckv1.Destination
 
+	// GetServiceExposure returns how the service is exposed outside the cluster, nil if it isn't.
+	GetServiceExposure() *operatorapi.ExposureSpec
+	// GetServiceHTTPRoute returns how the service is routed from a Gateway API Gateway, nil if it isn't.
+	GetServiceHTTPRoute() *operatorapi.HTTPRouteSpec
+	// GetPersistenceServiceNamespace returns the namespace of the in-cluster PostgreSQL service used by the service, empty if there's none.
+	GetPersistenceServiceNamespace() string
+	// SetServiceEndpointInPlatformStatus sets the external url of the service in the platform's status. An empty url removes it.
+	SetServiceEndpointInPlatformStatus(url string)
+
 	// Check if K_SINK has injected for Job Service. No Op for Data Index
 	CheckKSinkInjected() (bool, error)
 }
@@ -197,12 +210,17 @@ func (d *DataIndexHandler) GetLocalServiceBaseUrl() string {
 }
 
 func (d *DataIndexHandler) GetEnvironmentVariables() []corev1.EnvVar {
-	return []corev1.EnvVar{
+	profile := "http-events-support"
+	if kafka.GetPlatformKafka(d.platform) != nil {
+		profile = "kafka-events-support"
+	}
+	env := append([]corev1.EnvVar{
 		{
 			Name:  "KOGITO_DATA_INDEX_QUARKUS_PROFILE",
-			Value: "http-events-support",
+			Value: profile,
 		},
-	}
+	}, security.ConfigureOIDCEnv(d.platform.Spec.Security.GetOIDC())...)
+	return append(env, kafka.ConfigureEnv(kafka.GetPlatformKafka(d.platform))...)
 }
 
 func (d *DataIndexHandler) GetPodResourceRequirements() corev1.ResourceRequirements {
@@ -274,10 +292,52 @@ func (d *DataIndexHandler) GetServiceSource() *duckv1.Destination {
 	return GetPlatformBroker(d.platform)
 }
 
+func (d *DataIndexHandler) GetServiceExposure() *operatorapi.ExposureSpec {
+	return d.platform.Spec.Services.DataIndex.Exposure
+}
+
+func (d *DataIndexHandler) GetServiceHTTPRoute() *operatorapi.HTTPRouteSpec {
+	return d.platform.Spec.Services.DataIndex.HTTPRoute
+}
+
+func (d *DataIndexHandler) GetPersistenceServiceNamespace() string {
+	if !d.hasPostgreSQLConfigured() {
+		return ""
+	}
+	p := persistence.RetrievePostgreSQLConfiguration(d.platform.Spec.Services.DataIndex.Persistence, d.platform.Spec.Persistence, d.GetServiceName())
+	return persistence.PostgreSQLServiceNamespace(p.PostgreSQL, d.platform.Namespace)
+}
+
+func (d *DataIndexHandler) SetServiceEndpointInPlatformStatus(url string) {
+	if len(url) == 0 {
+		if d.platform.Status.Endpoints != nil {
+			d.platform.Status.Endpoints.DataIndexRef = nil
+		}
+		return
+	}
+	if d.platform.Status.Endpoints == nil {
+		d.platform.Status.Endpoints = &operatorapi.PlatformServicesStatus{}
+	}
+	d.platform.Status.Endpoints.DataIndexRef = &operatorapi.PlatformServiceRefStatus{Url: url}
+}
+
 func (d *DataIndexHandler) GenerateServiceProperties() (*properties.Properties, error) {
 	props := properties.NewProperties()
 	props.Set(constants.KogitoServiceURLProperty, d.GetLocalServiceBaseUrl())
 	props.Set(constants.DataIndexKafkaHealthCheck, "false")
+	if kafkaSpec := kafka.GetPlatformKafka(d.platform); kafkaSpec != nil {
+		props.Set(constants.DataIndexKafkaHealthCheck, "true")
+		props.Merge(kafka.GetClientProperties(kafkaSpec))
+		for _, channel := range []string{constants.KogitoProcessInstancesEventsChannel, constants.KogitoProcessDefinitionsEventsChannel, constants.KogitoJobsEventsChannel} {
+			props.Set(utils.MessagingChannelProperty(utils.IncomingChannel, channel, kafka.TopicAttribute), kafka.TopicName(d.platform, channel))
+		}
+	}
+	// the events endpoints are kept open for the workflows and the Jobs Service
+	props.Merge(security.GetOIDCServiceProperties(d.platform.Spec.Security.GetOIDC(), "/graphql", "/graphql/*"))
+	if certmanager.PlatformUsesTLS(d.platform) {
+		props.Merge(certmanager.GetTLSProperties())
+	}
+	props.Sort()
 	return props, nil
 }
 
@@ -374,7 +434,8 @@ func (j *JobServiceHandler) GetLocalServiceBaseUrl() string {
 }
 
 func (j *JobServiceHandler) GetEnvironmentVariables() []corev1.EnvVar {
-	return []corev1.EnvVar{}
+	env := append([]corev1.EnvVar{}, security.ConfigureOIDCEnv(j.platform.Spec.Security.GetOIDC())...)
+	return append(env, kafka.ConfigureEnv(kafka.GetPlatformKafka(j.platform))...)
 }
 
 func (j *JobServiceHandler) GetPodResourceRequirements() corev1.ResourceRequirements {
@@ -463,7 +524,17 @@ func (j *JobServiceHandler) GenerateServiceProperties() (*properties.Properties,
 		props.Set(constants.JobServiceDataSourceReactiveURL, dataSourceReactiveURL)
 	}
 
-	if isDataIndexEnabled(j.platform) {
+	kafkaSpec := kafka.GetPlatformKafka(j.platform)
+	if kafkaSpec != nil {
+		props.Merge(kafka.GetClientProperties(kafkaSpec))
+		props.Set(utils.MessagingChannelProperty(utils.IncomingChannel, constants.JobServiceRequestEventsChannel, kafka.ConnectorAttribute), kafka.SmallRyeKafkaConnector)
+		props.Set(utils.MessagingChannelProperty(utils.IncomingChannel, constants.JobServiceRequestEventsChannel, kafka.TopicAttribute), kafka.TopicName(j.platform, constants.JobServiceRequestEventsChannel))
+	}
+
+	if isDataIndexEnabled(j.platform) && kafkaSpec != nil {
+		props.Set(constants.JobServiceKafkaStatusChangeEvents, "true")
+		props.Merge(kafka.GetOutgoingChannelProperties(constants.JobServiceStatusEventsChannel, kafka.TopicName(j.platform, constants.KogitoJobsEventsChannel)))
+	} else if isDataIndexEnabled(j.platform) {
 		props.Set(constants.JobServiceStatusChangeEvents, "true")
 		if j.GetServiceSource() == nil {
 			di := NewDataIndexHandler(j.platform)
@@ -474,6 +545,13 @@ func (j *JobServiceHandler) GenerateServiceProperties() (*properties.Properties,
 			props.Set(constants.JobServiceStatusChangeEventsMethod, constants.Post)
 		}
 	}
+	if certmanager.PlatformUsesTLS(j.platform) {
+		props.Merge(certmanager.GetTLSProperties())
+	}
+	// the workflows schedule their jobs with the REST API unless they're sourced from Knative Eventing
+	if j.GetServiceSource() != nil {
+		props.Merge(security.GetOIDCServiceProperties(j.platform.Spec.Security.GetOIDC(), "/jobs", "/jobs/*", "/v2/jobs", "/v2/jobs/*"))
+	}
 	props.Sort()
 	return props, nil
 }
@@ -494,6 +572,35 @@ func (j *JobServiceHandler) GetServiceSource() *duckv1.Destination {
 	return GetPlatformBroker(j.platform)
 }
 
+func (j *JobServiceHandler) GetServiceExposure() *operatorapi.ExposureSpec {
+	return j.platform.Spec.Services.JobService.Exposure
+}
+
+func (j *JobServiceHandler) GetServiceHTTPRoute() *operatorapi.HTTPRouteSpec {
+	return j.platform.Spec.Services.JobService.HTTPRoute
+}
+
+func (j *JobServiceHandler) GetPersistenceServiceNamespace() string {
+	if !j.hasPostgreSQLConfigured() {
+		return ""
+	}
+	p := persistence.RetrievePostgreSQLConfiguration(j.platform.Spec.Services.JobService.Persistence, j.platform.Spec.Persistence, j.GetServiceName())
+	return persistence.PostgreSQLServiceNamespace(p.PostgreSQL, j.platform.Namespace)
+}
+
+func (j *JobServiceHandler) SetServiceEndpointInPlatformStatus(url string) {
+	if len(url) == 0 {
+		if j.platform.Status.Endpoints != nil {
+			j.platform.Status.Endpoints.JobServiceRef = nil
+		}
+		return
+	}
+	if j.platform.Status.Endpoints == nil {
+		j.platform.Status.Endpoints = &operatorapi.PlatformServicesStatus{}
+	}
+	j.platform.Status.Endpoints.JobServiceRef = &operatorapi.PlatformServiceRefStatus{Url: url}
+}
+
 func (j *JobServiceHandler) GetServiceSink() *duckv1.Destination {
 	if j.platform.Spec.Services.JobService.Sink != nil {
 		return j.platform.Spec.Services.JobService.Sink
@@ -620,7 +727,7 @@ func (d *DataIndexHandler) GenerateKnativeResources(platform *operatorapi.Sonata
 		return nil, event, err
 	}
 	serviceName := d.GetServiceName()
-	return []client.Object{
+	triggers := []*eventingv1.Trigger{
 		d.newTrigger(lbl, brokerName, namespace, serviceName, "process-error", "ProcessInstanceErrorDataEvent", constants.KogitoProcessInstancesEventsPath, platform),
 		d.newTrigger(lbl, brokerName, namespace, serviceName, "process-node", "ProcessInstanceNodeDataEvent", constants.KogitoProcessInstancesEventsPath, platform),
 		d.newTrigger(lbl, brokerName, namespace, serviceName, "process-sla", "ProcessInstanceSLADataEvent", constants.KogitoProcessInstancesEventsPath, platform),
@@ -628,7 +735,31 @@ func (d *DataIndexHandler) GenerateKnativeResources(platform *operatorapi.Sonata
 		d.newTrigger(lbl, brokerName, namespace, serviceName, "process-variable", "ProcessInstanceVariableDataEvent", constants.KogitoProcessInstancesEventsPath, platform),
 		d.newTrigger(lbl, brokerName, namespace, serviceName, "process-definition", "ProcessDefinitionEvent", constants.KogitoProcessDefinitionsEventsPath, platform),
 		d.newTrigger(lbl, brokerName, namespace, serviceName, "process-instance-multiple", "MultipleProcessInstanceDataEvent", constants.KogitoProcessInstancesMultiEventsPath, platform),
-		d.newTrigger(lbl, brokerName, namespace, serviceName, "jobs", "JobEvent", constants.KogitoJobsPath, platform)}, nil, nil
+		d.newTrigger(lbl, brokerName, namespace, serviceName, "jobs", "JobEvent", constants.KogitoJobsPath, platform)}
+	resultObjs := []client.Object{}
+	for _, trigger := range triggers {
+		resultObjs = append(resultObjs, trigger)
+	}
+	eventTypes, err := newConsumedEventTypes(platform, lbl, serviceName, triggers...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return append(resultObjs, eventTypes...), nil, nil
+}
+
+// newConsumedEventTypes creates the EventTypes describing the events delivered by the given triggers to a platform service,
+// named after their trigger.
+func newConsumedEventTypes(platform *operatorapi.SonataFlowPlatform, lbl map[string]string, serviceName string, triggers ...*eventingv1.Trigger) ([]client.Object, error) {
+	var eventTypes []client.Object
+	for _, trigger := range triggers {
+		eventType, err := knative.NewEventType(trigger.Name, lbl, knative.NewBrokerReference(trigger.Spec.Broker, trigger.Namespace),
+			trigger.Spec.Filter.Attributes["type"], "", "", fmt.Sprintf("Consumed by the platform service %s/%s", platform.Namespace, serviceName))
+		if err != nil {
+			return nil, err
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+	return eventTypes, nil
 }
 
 func (d JobServiceHandler) GetSourceBroker() *duckv1.Destination {
@@ -718,6 +849,23 @@ func (j *JobServiceHandler) GenerateKnativeResources(platform *operatorapi.Sonat
 			},
 		}
 		resultObjs = append(resultObjs, jobDeleteTrigger)
+		eventTypes, err := newConsumedEventTypes(platform, lbl, j.GetServiceName(), jobCreateTrigger, jobDeleteTrigger)
+		if err != nil {
+			return nil, nil, err
+		}
+		resultObjs = append(resultObjs, eventTypes...)
+	}
+	if sink != nil && sink.Ref != nil && knative.IsKnativeBroker(sink.Ref) {
+		namespace := sink.Ref.Namespace
+		if len(namespace) == 0 {
+			namespace = platform.Namespace
+		}
+		jobEventType, err := knative.NewEventType(kmeta.ChildName("jobs-service-job-event-", string(platform.GetUID())), lbl, knative.NewBrokerReference(sink.Ref.Name, namespace),
+			"JobEvent", "", "", fmt.Sprintf("Produced by the platform service %s/%s", platform.Namespace, j.GetServiceName()))
+		if err != nil {
+			return nil, nil, err
+		}
+		resultObjs = append(resultObjs, jobEventType)
 	}
 	if sink != nil {
 		sinkBinding := &sourcesv1.SinkBinding{
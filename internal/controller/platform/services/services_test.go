@@ -21,8 +21,15 @@ import (
 	"testing"
 
 	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/certmanager"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/constants"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/test"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	eventingv1beta2 "knative.dev/eventing/pkg/apis/eventing/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestMergeContainerSpec(t *testing.T) {
@@ -54,3 +61,165 @@ func TestMergeContainerPreservingEnvVars(t *testing.T) {
 	assert.Equal(t, container1.Env[1], corev1.EnvVar{Name: "var2", Value: "value2"})
 	assert.Equal(t, container1.Env[2], corev1.EnvVar{Name: "var3", Value: "value3"})
 }
+
+func TestDataIndexHandler_OIDC(t *testing.T) {
+	platform := &operatorapi.SonataFlowPlatform{
+		Spec: operatorapi.SonataFlowPlatformSpec{
+			Security: &operatorapi.SecuritySpec{OIDC: &operatorapi.OIDCSpec{
+				AuthServerURL: "https://keycloak.example.com/realms/sonataflow",
+				ClientID:      "data-index",
+				ClientSecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "oidc-secret"},
+					Key:                  "client-secret",
+				},
+			}},
+		},
+	}
+	di := NewDataIndexHandler(platform)
+	props, err := di.GenerateServiceProperties()
+	assert.NoError(t, err)
+	assert.Equal(t, "data-index", props.GetString("quarkus.oidc.client-id", ""))
+	assert.Equal(t, "/graphql,/graphql/*", props.GetString("quarkus.http.auth.permission.sonataflow-endpoints.paths", ""))
+	env := di.GetEnvironmentVariables()
+	assert.Len(t, env, 2)
+	assert.Equal(t, "QUARKUS_OIDC_CREDENTIALS_SECRET", env[1].Name)
+}
+
+func TestJobServiceHandler_TLS(t *testing.T) {
+	platform := &operatorapi.SonataFlowPlatform{
+		ObjectMeta: metav1.ObjectMeta{Name: "sonataflow-platform", Namespace: "sonataflow"},
+		Spec: operatorapi.SonataFlowPlatformSpec{
+			Services: &operatorapi.ServicesPlatformSpec{JobService: &operatorapi.JobServiceServiceSpec{}},
+			TLS:      &operatorapi.PlatformTLSSpec{IssuerRef: operatorapi.CertManagerIssuerRef{Name: "sonataflow-ca"}},
+		},
+	}
+	js := NewJobServiceHandler(platform)
+	// without cert-manager the certificate can't be issued
+	props, err := js.GenerateServiceProperties()
+	assert.NoError(t, err)
+	assert.Empty(t, props.GetString("quarkus.http.ssl-port", ""))
+
+	utils.SetDiscoveryClient(test.CreateFakeCertManagerDiscoveryClient())
+	assert.NoError(t, certmanager.SetAvailable(nil))
+	defer func() {
+		utils.SetDiscoveryClient(test.CreateFakeKnativeAndMonitoringDiscoveryClient())
+		assert.NoError(t, certmanager.SetAvailable(nil))
+	}()
+	// the in-cluster callbacks keep using HTTP, the services don't trust the issuer of the certificates
+	assert.Equal(t, "http://sonataflow-platform-jobs-service.sonataflow", js.GetLocalServiceBaseUrl())
+	props, err = js.GenerateServiceProperties()
+	assert.NoError(t, err)
+	assert.Equal(t, "8443", props.GetString("quarkus.http.ssl-port", ""))
+	assert.Equal(t, "/deployments/tls/tls.crt", props.GetString("quarkus.http.ssl.certificate.files", ""))
+	assert.Equal(t, "/deployments/tls/tls.key", props.GetString("quarkus.http.ssl.certificate.key-files", ""))
+}
+
+func TestPlatformServices_Kafka(t *testing.T) {
+	platform := &operatorapi.SonataFlowPlatform{
+		ObjectMeta: metav1.ObjectMeta{Name: "sonataflow-platform", Namespace: "sonataflow"},
+		Spec: operatorapi.SonataFlowPlatformSpec{
+			Services: &operatorapi.ServicesPlatformSpec{
+				DataIndex:  &operatorapi.DataIndexServiceSpec{ServiceSpec: operatorapi.ServiceSpec{Enabled: &enabled}},
+				JobService: &operatorapi.JobServiceServiceSpec{ServiceSpec: operatorapi.ServiceSpec{Enabled: &enabled}},
+			},
+			Eventing: &operatorapi.PlatformEventingSpec{Kafka: &operatorapi.PlatformKafkaEventingSpec{
+				BootstrapServers: "my-cluster-kafka-bootstrap.kafka:9092",
+				TopicNaming:      operatorapi.NamespacePrefixedKafkaTopicNaming,
+				Security: &operatorapi.KafkaSecuritySpec{
+					Protocol: operatorapi.KafkaSASLPlaintextSecurityProtocol,
+					SASLJAASConfigRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "kafka-user"},
+						Key:                  "sasl.jaas.config",
+					},
+				},
+			}},
+		},
+	}
+	di := NewDataIndexHandler(platform)
+	env := di.GetEnvironmentVariables()
+	assert.Len(t, env, 2)
+	assert.Equal(t, corev1.EnvVar{Name: "KOGITO_DATA_INDEX_QUARKUS_PROFILE", Value: "kafka-events-support"}, env[0])
+	assert.Equal(t, "KAFKA_SASL_JAAS_CONFIG", env[1].Name)
+	props, err := di.GenerateServiceProperties()
+	assert.NoError(t, err)
+	props.DisableExpansion = true
+	assert.Equal(t, "my-cluster-kafka-bootstrap.kafka:9092", props.GetString("kafka.bootstrap.servers", ""))
+	assert.Equal(t, "true", props.GetString(constants.DataIndexKafkaHealthCheck, ""))
+	assert.Equal(t, "sonataflow.kogito-processinstances-events", props.GetString("mp.messaging.incoming.kogito-processinstances-events.topic", ""))
+	assert.Equal(t, "sonataflow.kogito-processdefinitions-events", props.GetString("mp.messaging.incoming.kogito-processdefinitions-events.topic", ""))
+	assert.Equal(t, "sonataflow.kogito-jobs-events", props.GetString("mp.messaging.incoming.kogito-jobs-events.topic", ""))
+
+	js := NewJobServiceHandler(platform)
+	assert.Len(t, js.GetEnvironmentVariables(), 1)
+	props, err = js.GenerateServiceProperties()
+	assert.NoError(t, err)
+	props.DisableExpansion = true
+	assert.Equal(t, "SASL_PLAINTEXT", props.GetString("kafka.security.protocol", ""))
+	assert.Equal(t, "smallrye-kafka", props.GetString("mp.messaging.incoming.kogito-job-service-job-request-events.connector", ""))
+	assert.Equal(t, "sonataflow.kogito-job-service-job-request-events", props.GetString("mp.messaging.incoming.kogito-job-service-job-request-events.topic", ""))
+	assert.Equal(t, "true", props.GetString(constants.JobServiceKafkaStatusChangeEvents, ""))
+	assert.Equal(t, "sonataflow.kogito-jobs-events", props.GetString("mp.messaging.outgoing.kogito-job-service-job-status-events.topic", ""))
+	_, found := props.Get(constants.JobServiceStatusChangeEventsURL)
+	assert.False(t, found)
+
+	workflow := &operatorapi.SonataFlow{
+		ObjectMeta: metav1.ObjectMeta{Name: "greeting", Namespace: "sonataflow"},
+		Status: operatorapi.SonataFlowStatus{Services: &operatorapi.PlatformServicesStatus{
+			DataIndexRef:  &operatorapi.PlatformServiceRefStatus{Url: di.GetLocalServiceBaseUrl()},
+			JobServiceRef: &operatorapi.PlatformServiceRefStatus{Url: js.GetLocalServiceBaseUrl()},
+		}},
+	}
+	props, err = GenerateDataIndexWorkflowProperties(workflow, platform)
+	assert.NoError(t, err)
+	assert.Equal(t, "smallrye-kafka", props.GetString(constants.KogitoProcessInstancesEventsConnector, ""))
+	assert.Equal(t, "sonataflow.kogito-processinstances-events", props.GetString("mp.messaging.outgoing.kogito-processinstances-events.topic", ""))
+	_, found = props.Get(constants.KogitoProcessInstancesEventsURL)
+	assert.False(t, found)
+	props, err = GenerateJobServiceWorkflowProperties(workflow, platform)
+	assert.NoError(t, err)
+	assert.Equal(t, "smallrye-kafka", props.GetString(constants.JobServiceRequestEventsConnector, ""))
+	assert.Equal(t, "sonataflow.kogito-job-service-job-request-events", props.GetString("mp.messaging.outgoing.kogito-job-service-job-request-events.topic", ""))
+	_, found = props.Get(constants.JobServiceRequestEventsURL)
+	assert.False(t, found)
+}
+
+func TestPlatformServices_KnativeEventTypes(t *testing.T) {
+	platform := test.GetBasePlatformWithBroker()
+	platform.Namespace = "sonataflow"
+	platform.Spec.Eventing.Broker.Ref.Namespace = platform.Namespace
+	platform.Spec.Services = &operatorapi.ServicesPlatformSpec{
+		DataIndex:  &operatorapi.DataIndexServiceSpec{ServiceSpec: operatorapi.ServiceSpec{Enabled: &enabled}},
+		JobService: &operatorapi.JobServiceServiceSpec{ServiceSpec: operatorapi.ServiceSpec{Enabled: &enabled}},
+	}
+	broker := test.GetDefaultBroker(platform.Namespace)
+	utils.SetClient(test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(broker).WithStatusSubresource(broker).Build())
+
+	objs, _, err := NewDataIndexHandler(platform).GenerateKnativeResources(platform, map[string]string{})
+	assert.NoError(t, err)
+	eventTypes := filterEventTypes(objs)
+	assert.Len(t, eventTypes, 8)
+	for _, eventType := range eventTypes {
+		assert.Equal(t, "default", eventType.Spec.Reference.Name)
+		assert.Equal(t, platform.Namespace, eventType.Namespace)
+		assert.Equal(t, "Consumed by the platform service sonataflow/"+platform.Name+"-data-index-service", eventType.Spec.Description)
+	}
+
+	objs, _, err = NewJobServiceHandler(platform).GenerateKnativeResources(platform, map[string]string{})
+	assert.NoError(t, err)
+	eventTypes = filterEventTypes(objs)
+	assert.Len(t, eventTypes, 3)
+	assert.Equal(t, "job.create", eventTypes[0].Spec.Type)
+	assert.Equal(t, "job.delete", eventTypes[1].Spec.Type)
+	assert.Equal(t, "JobEvent", eventTypes[2].Spec.Type)
+	assert.Equal(t, "Produced by the platform service sonataflow/"+platform.Name+"-jobs-service", eventTypes[2].Spec.Description)
+}
+
+func filterEventTypes(objs []client.Object) []*eventingv1beta2.EventType {
+	var eventTypes []*eventingv1beta2.EventType
+	for _, obj := range objs {
+		if eventType, ok := obj.(*eventingv1beta2.EventType); ok {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+	return eventTypes
+}
@@ -0,0 +1,257 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api/metadata"
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/log"
+	kubeutil "github.com/apache/incubator-kie-kogito-serverless-operator/utils/kubernetes"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/workflowproj"
+)
+
+// templateHashAnnotation holds the hash of the desired Deployment spec rolled out in a given color
+const templateHashAnnotation = metadata.Domain + "/template-hash"
+
+var _ ObjectEnsurerWithPlatform = &blueGreenDeploymentEnsurer{}
+
+// NewBlueGreenDeploymentEnsurer creates an ObjectEnsurerWithPlatform that rolls out the workflow Deployment in blue/green colors.
+// Changes are applied to the preview color, once it's ready the active color is switched and the previous Deployment drained.
+// The rollout progress is kept in the workflow status, the caller must persist it.
+func NewBlueGreenDeploymentEnsurer(c client.Client) ObjectEnsurerWithPlatform {
+	return &blueGreenDeploymentEnsurer{c: c}
+}
+
+type blueGreenDeploymentEnsurer struct {
+	c client.Client
+}
+
+// GetBlueGreenDeploymentName gets the Deployment name of the given color, which must not be empty.
+func GetBlueGreenDeploymentName(workflow *operatorapi.SonataFlow, color operatorapi.DeploymentColor) string {
+	return fmt.Sprintf("%s-%s", workflow.Name, color)
+}
+
+// getBlueGreenActiveColor gets the color receiving the traffic, empty if the blue/green rollout is disabled or hasn't switched yet.
+func getBlueGreenActiveColor(workflow *operatorapi.SonataFlow) operatorapi.DeploymentColor {
+	if !workflow.IsBlueGreenDeployment() || workflow.Status.BlueGreen == nil {
+		return ""
+	}
+	return workflow.Status.BlueGreen.ActiveColor
+}
+
+// getBlueGreenFollowedColor gets the color whose Deployment status should be reported in the workflow status.
+func getBlueGreenFollowedColor(workflow *operatorapi.SonataFlow) operatorapi.DeploymentColor {
+	if workflow.Status.BlueGreen == nil {
+		return ""
+	}
+	if len(workflow.Status.BlueGreen.ActiveColor) > 0 {
+		return workflow.Status.BlueGreen.ActiveColor
+	}
+	return workflow.Status.BlueGreen.PreviewColor
+}
+
+func (b *blueGreenDeploymentEnsurer) Ensure(ctx context.Context, workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform, visitors ...MutateVisitor) (client.Object, controllerutil.OperationResult, error) {
+	if workflow.Status.BlueGreen == nil {
+		workflow.Status.BlueGreen = &operatorapi.BlueGreenStatus{}
+	}
+	status := workflow.Status.BlueGreen
+	if err := b.drain(ctx, workflow); err != nil {
+		return nil, controllerutil.OperationResultNone, err
+	}
+	hash, err := b.desiredHash(workflow, pl, visitors)
+	if err != nil {
+		return nil, controllerutil.OperationResultNone, err
+	}
+
+	if len(status.ActiveColor) > 0 {
+		active, err := b.get(ctx, workflow, status.ActiveColor)
+		if err != nil {
+			return nil, controllerutil.OperationResultNone, err
+		}
+		// nothing to roll out, or the active Deployment is gone and must be recreated in place
+		if active == nil || active.Annotations[templateHashAnnotation] == hash {
+			if len(status.PreviewColor) > 0 {
+				klog.V(log.I).InfoS("Discarding blue/green preview", "workflow", workflow.Name, "color", status.PreviewColor)
+				if err := b.delete(ctx, workflow, GetBlueGreenDeploymentName(workflow, status.PreviewColor)); err != nil {
+					return nil, controllerutil.OperationResultNone, err
+				}
+				status.PreviewColor = ""
+			}
+			return b.apply(ctx, workflow, pl, status.ActiveColor, hash, visitors)
+		}
+	}
+
+	status.PreviewColor = status.ActiveColor.Other()
+	if status.DrainingDeployment == GetBlueGreenDeploymentName(workflow, status.PreviewColor) {
+		// the draining Deployment gets the new revision, it's not receiving traffic anymore
+		status.DrainingDeployment = ""
+		status.DrainingSince = nil
+	}
+	preview, result, err := b.apply(ctx, workflow, pl, status.PreviewColor, hash, visitors)
+	if err != nil || !isBlueGreenDeploymentReady(preview.(*appsv1.Deployment)) {
+		return preview, result, err
+	}
+
+	now := metav1.Now()
+	if len(status.ActiveColor) > 0 {
+		status.DrainingDeployment = GetBlueGreenDeploymentName(workflow, status.ActiveColor)
+		status.DrainingSince = &now
+	} else if legacy, err := b.getByName(ctx, workflow, workflow.Name); err != nil {
+		return nil, controllerutil.OperationResultNone, err
+	} else if legacy != nil {
+		// Deployment created before enabling the blue/green rollout
+		status.DrainingDeployment = legacy.Name
+		status.DrainingSince = &now
+	}
+	klog.V(log.I).InfoS("Switching blue/green active color", "workflow", workflow.Name, "from", status.ActiveColor, "to", status.PreviewColor)
+	status.ActiveColor = status.PreviewColor
+	status.PreviewColor = ""
+	return preview, result, nil
+}
+
+// desiredHash computes the hash of the Deployment spec the workflow should be running.
+func (b *blueGreenDeploymentEnsurer) desiredHash(workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform, visitors []MutateVisitor) (string, error) {
+	object, err := DeploymentCreator(workflow, pl)
+	if err != nil {
+		return "", err
+	}
+	for _, v := range visitors {
+		if err := v(object)(); err != nil {
+			return "", err
+		}
+	}
+	spec, err := json.Marshal(object.(*appsv1.Deployment).Spec)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%08x", crc32.ChecksumIEEE(spec)), nil
+}
+
+func (b *blueGreenDeploymentEnsurer) apply(ctx context.Context, workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform,
+	color operatorapi.DeploymentColor, hash string, visitors []MutateVisitor) (client.Object, controllerutil.OperationResult, error) {
+	object, err := DeploymentCreator(workflow, pl)
+	if err != nil {
+		return nil, controllerutil.OperationResultNone, err
+	}
+	deployment := object.(*appsv1.Deployment)
+	deployment.Name = GetBlueGreenDeploymentName(workflow, color)
+	result, err := controllerutil.CreateOrPatch(ctx, b.c, deployment, func() error {
+		for _, v := range visitors {
+			if err := v(deployment)(); err != nil {
+				return err
+			}
+		}
+		// visitors restore the default labels, the color must be added afterward
+		setDeploymentColor(deployment, color)
+		if deployment.Annotations == nil {
+			deployment.Annotations = map[string]string{}
+		}
+		deployment.Annotations[templateHashAnnotation] = hash
+		return controllerutil.SetControllerReference(workflow, deployment, b.c.Scheme())
+	})
+	if err != nil {
+		return nil, result, err
+	}
+	klog.V(log.I).InfoS("Object operation finalized", "result", result, "kind", "Deployment", "name", deployment.Name, "namespace", deployment.Namespace)
+	return deployment, result, nil
+}
+
+func (b *blueGreenDeploymentEnsurer) drain(ctx context.Context, workflow *operatorapi.SonataFlow) error {
+	status := workflow.Status.BlueGreen
+	if len(status.DrainingDeployment) == 0 || status.DrainingSince == nil {
+		return nil
+	}
+	if time.Since(status.DrainingSince.Time) < workflow.Spec.PodTemplate.BlueGreen.GetDrainPeriod().Duration {
+		return nil
+	}
+	klog.V(log.I).InfoS("Removing drained Deployment", "workflow", workflow.Name, "deployment", status.DrainingDeployment)
+	if err := b.delete(ctx, workflow, status.DrainingDeployment); err != nil {
+		return err
+	}
+	status.DrainingDeployment = ""
+	status.DrainingSince = nil
+	return nil
+}
+
+func (b *blueGreenDeploymentEnsurer) get(ctx context.Context, workflow *operatorapi.SonataFlow, color operatorapi.DeploymentColor) (*appsv1.Deployment, error) {
+	return b.getByName(ctx, workflow, GetBlueGreenDeploymentName(workflow, color))
+}
+
+func (b *blueGreenDeploymentEnsurer) getByName(ctx context.Context, workflow *operatorapi.SonataFlow, name string) (*appsv1.Deployment, error) {
+	deployment := &appsv1.Deployment{}
+	if err := b.c.Get(ctx, types.NamespacedName{Namespace: workflow.Namespace, Name: name}, deployment); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return deployment, nil
+}
+
+func (b *blueGreenDeploymentEnsurer) delete(ctx context.Context, workflow *operatorapi.SonataFlow, name string) error {
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: workflow.Namespace}}
+	return client.IgnoreNotFound(b.c.Delete(ctx, deployment))
+}
+
+// CleanupBlueGreenDeployments removes the blue/green Deployments once the rollout has been disabled and the regular Deployment is running.
+func CleanupBlueGreenDeployments(ctx context.Context, c client.Client, workflow *operatorapi.SonataFlow) error {
+	if workflow.IsBlueGreenDeployment() || workflow.Status.BlueGreen == nil || !workflow.Status.IsReady() {
+		return nil
+	}
+	ensurer := &blueGreenDeploymentEnsurer{c: c}
+	for _, color := range []operatorapi.DeploymentColor{operatorapi.BlueDeploymentColor, operatorapi.GreenDeploymentColor} {
+		if err := ensurer.delete(ctx, workflow, GetBlueGreenDeploymentName(workflow, color)); err != nil {
+			return err
+		}
+	}
+	workflow.Status.BlueGreen = nil
+	return nil
+}
+
+func setDeploymentColor(deployment *appsv1.Deployment, color operatorapi.DeploymentColor) {
+	if deployment.Labels == nil {
+		deployment.Labels = map[string]string{}
+	}
+	deployment.Labels[workflowproj.LabelDeploymentColor] = string(color)
+	if deployment.Spec.Selector.MatchLabels == nil {
+		deployment.Spec.Selector.MatchLabels = map[string]string{}
+	}
+	deployment.Spec.Selector.MatchLabels[workflowproj.LabelDeploymentColor] = string(color)
+	if deployment.Spec.Template.Labels == nil {
+		deployment.Spec.Template.Labels = map[string]string{}
+	}
+	deployment.Spec.Template.Labels[workflowproj.LabelDeploymentColor] = string(color)
+}
+
+func isBlueGreenDeploymentReady(deployment *appsv1.Deployment) bool {
+	return deployment.Status.ObservedGeneration >= deployment.Generation && kubeutil.IsDeploymentAvailable(deployment)
+}
@@ -0,0 +1,100 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/test"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/workflowproj"
+)
+
+func markDeploymentAvailable(t *testing.T, cli client.Client, name types.NamespacedName) {
+	deployment := &appsv1.Deployment{}
+	assert.NoError(t, cli.Get(context.TODO(), name, deployment))
+	deployment.Status.Conditions = []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue}}
+	assert.NoError(t, cli.Status().Update(context.TODO(), deployment))
+}
+
+func TestBlueGreenDeploymentEnsurer(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Spec.PodTemplate.BlueGreen = &v1alpha08.BlueGreenRolloutSpec{}
+	cli := test.NewSonataFlowClientBuilder().WithRuntimeObjects(workflow).Build()
+	ensurer := NewBlueGreenDeploymentEnsurer(cli)
+	ctx := context.TODO()
+	blue := types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name + "-blue"}
+	green := types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name + "-green"}
+
+	// first rollout, nothing is active until the blue Deployment is ready
+	object, _, err := ensurer.Ensure(ctx, workflow, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, blue.Name, object.GetName())
+	assert.Equal(t, "blue", object.(*appsv1.Deployment).Spec.Selector.MatchLabels[workflowproj.LabelDeploymentColor])
+	assert.Equal(t, v1alpha08.BlueDeploymentColor, workflow.Status.BlueGreen.PreviewColor)
+	assert.Empty(t, workflow.Status.BlueGreen.ActiveColor)
+
+	markDeploymentAvailable(t, cli, blue)
+	_, _, err = ensurer.Ensure(ctx, workflow, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha08.BlueDeploymentColor, workflow.Status.BlueGreen.ActiveColor)
+	assert.Empty(t, workflow.Status.BlueGreen.PreviewColor)
+	service, err := ServiceCreator(workflow)
+	assert.NoError(t, err)
+	assert.Equal(t, "blue", service.(*corev1.Service).Spec.Selector[workflowproj.LabelDeploymentColor])
+
+	// reconciling the same spec keeps the active Deployment
+	_, _, err = ensurer.Ensure(ctx, workflow, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, workflow.Status.BlueGreen.PreviewColor)
+
+	// a new revision goes to green while blue keeps the traffic
+	workflow.Spec.PodTemplate.Replicas = utils.Pint(2)
+	object, _, err = ensurer.Ensure(ctx, workflow, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, green.Name, object.GetName())
+	assert.Equal(t, v1alpha08.BlueDeploymentColor, workflow.Status.BlueGreen.ActiveColor)
+	assert.Equal(t, v1alpha08.GreenDeploymentColor, workflow.Status.BlueGreen.PreviewColor)
+
+	markDeploymentAvailable(t, cli, green)
+	_, _, err = ensurer.Ensure(ctx, workflow, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha08.GreenDeploymentColor, workflow.Status.BlueGreen.ActiveColor)
+	assert.Equal(t, blue.Name, workflow.Status.BlueGreen.DrainingDeployment)
+
+	// blue is removed once the drain period elapses
+	workflow.Status.BlueGreen.DrainingSince = &metav1.Time{Time: time.Now().Add(-10 * time.Minute)}
+	_, _, err = ensurer.Ensure(ctx, workflow, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, workflow.Status.BlueGreen.DrainingDeployment)
+	assert.True(t, errors.IsNotFound(cli.Get(ctx, blue, &appsv1.Deployment{})))
+	assert.NoError(t, cli.Get(ctx, green, &appsv1.Deployment{}))
+}
@@ -25,6 +25,9 @@ const (
 	DefaultHTTPProtocol              = "http"
 	ConfigMapWorkflowPropsVolumeName = "workflow-properties"
 
+	JobServiceRequestEventsChannel                  = "kogito-job-service-job-request-events"
+	JobServiceStatusEventsChannel                   = "kogito-job-service-job-status-events"
+	JobServiceKafkaStatusChangeEvents               = "kogito.jobs-service.kafka.job-status-change-events"
 	JobServiceRequestEventsURL                      = "mp.messaging.outgoing.kogito-job-service-job-request-events.url"
 	JobServiceRequestEventsConnector                = "mp.messaging.outgoing.kogito-job-service-job-request-events.connector"
 	JobServiceRequestEventsMethod                   = "mp.messaging.outgoing.kogito-job-service-job-request-events.method"
@@ -38,6 +41,7 @@ const (
 	JobServiceLeaderCheckExpirationInSeconds        = "kogito.jobs-service.management.leader-check.expiration-in-seconds"
 	DefaultJobServiceLeaderCheckExpirationInSeconds = "60"
 
+	KogitoProcessInstancesEventsChannel   = "kogito-processinstances-events"
 	KogitoProcessInstancesEventsConnector = "mp.messaging.outgoing.kogito-processinstances-events.connector"
 	KogitoProcessInstancesEventsMethod    = "mp.messaging.outgoing.kogito-processinstances-events.method"
 	KogitoProcessInstancesEventsURL       = "mp.messaging.outgoing.kogito-processinstances-events.url"
@@ -45,6 +49,7 @@ const (
 	KogitoProcessInstancesEventsPath      = "/processes"
 	// KogitoProcessInstancesMultiEventsPath Same value as KogitoProcessInstancesEventsPath intentionally
 	KogitoProcessInstancesMultiEventsPath       = "/processes"
+	KogitoProcessDefinitionsEventsChannel       = "kogito-processdefinitions-events"
 	KogitoProcessDefinitionsEventsConnector     = "mp.messaging.outgoing.kogito-processdefinitions-events.connector"
 	KogitoProcessDefinitionsEventsMethod        = "mp.messaging.outgoing.kogito-processdefinitions-events.method"
 	KogitoProcessDefinitionsEventsURL           = "mp.messaging.outgoing.kogito-processdefinitions-events.url"
@@ -53,6 +58,7 @@ const (
 	KogitoProcessDefinitionsEventsPath          = "/definitions"
 	KogitoUserTasksEventsEnabled                = "kogito.events.usertasks.enabled"
 	KogitoJobsPath                              = "/jobs"
+	KogitoJobsEventsChannel                     = "kogito-jobs-events"
 	// KogitoDataIndexHealthCheckEnabled configures if a workflow must check for the data index availability as part
 	// of its start health check.
 	KogitoDataIndexHealthCheckEnabled = "kogito.data-index.health-enabled"
@@ -25,8 +25,6 @@ const (
 	RequeueAfterFailure          = 3 * time.Minute
 	RequeueAfterFollowDeployment = 5 * time.Second
 	RequeueAfterIsRunning        = 1 * time.Minute
-	// RecoverDeploymentErrorRetries how many times the operator should try to recover from a failure before giving up
-	RecoverDeploymentErrorRetries = 3
 	// RequeueRecoverDeploymentErrorInterval interval between recovering from failures
 	RequeueRecoverDeploymentErrorInterval = RecoverDeploymentErrorInterval * time.Minute
 	RecoverDeploymentErrorInterval        = 10
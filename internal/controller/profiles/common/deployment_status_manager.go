@@ -34,6 +34,7 @@ import (
 
 	"github.com/apache/incubator-kie-kogito-serverless-operator/api"
 	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/platform"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/constants"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/log"
 	kubeutil "github.com/apache/incubator-kie-kogito-serverless-operator/utils/kubernetes"
@@ -63,6 +64,10 @@ type deploymentHandler struct {
 }
 
 func (d *deploymentHandler) getDeployment(ctx context.Context, workflow *operatorapi.SonataFlow) (*appsv1.Deployment, error) {
+	if workflow.IsJobDeployment() {
+		// the workflow instance is run to completion, there's no Deployment to roll out
+		return nil, nil
+	}
 	deploymentName := workflow.Name
 	if workflow.IsKnativeDeployment() {
 		ksvc := &servingv1.Service{}
@@ -73,6 +78,8 @@ func (d *deploymentHandler) getDeployment(ctx context.Context, workflow *operato
 			return nil, err
 		}
 		deploymentName = ksvc.Status.LatestCreatedRevisionName + knativeDeploymentSuffix
+	} else if color := getBlueGreenFollowedColor(workflow); workflow.IsBlueGreenDeployment() && len(color) > 0 {
+		deploymentName = GetBlueGreenDeploymentName(workflow, color)
 	}
 	deployment := &appsv1.Deployment{}
 	if err := d.c.Get(ctx, types.NamespacedName{Namespace: workflow.Namespace, Name: deploymentName}, deployment); err != nil {
@@ -96,6 +103,9 @@ func (d *deploymentHandler) RolloutDeployment(ctx context.Context, workflow *ope
 }
 
 func (d *deploymentHandler) SyncDeploymentStatus(ctx context.Context, workflow *operatorapi.SonataFlow) (ctrl.Result, error) {
+	if workflow.IsJobDeployment() {
+		return d.syncJobStatus(ctx, workflow)
+	}
 	deployment, err := d.getDeployment(ctx, workflow)
 	if err != nil || deployment == nil {
 		// we should have the deployment by this time, so even if the error above is not found, we should halt.
@@ -105,6 +115,8 @@ func (d *deploymentHandler) SyncDeploymentStatus(ctx context.Context, workflow *
 
 	// Deployment is available, we can return after setting Running = TRUE
 	if kubeutil.IsDeploymentAvailable(deployment) {
+		d.observeStartupTime(ctx, workflow, deployment)
+		workflow.Status.RecoverFailureAttempts = 0
 		workflow.Status.Manager().MarkTrue(api.RunningConditionType)
 		klog.V(log.I).InfoS("Workflow is in Running Condition")
 		return ctrl.Result{RequeueAfter: constants.RequeueAfterIsRunning}, nil
@@ -137,6 +149,28 @@ func (d *deploymentHandler) SyncDeploymentStatus(ctx context.Context, workflow *
 	return ctrl.Result{RequeueAfter: constants.RequeueAfterFollowDeployment, Requeue: true}, nil
 }
 
+// observeStartupTime keeps the longest startup time of the workflow pods in the workflow status, used to derive the startup
+// probe budget when the adaptive startup is enabled. The value is part of the pod template, so it only grows, and only once
+// the new time exceeds the kept one by adaptiveStartupHysteresisPercent, not to roll out the workflow on every small variation.
+func (d *deploymentHandler) observeStartupTime(ctx context.Context, workflow *operatorapi.SonataFlow, deployment *appsv1.Deployment) {
+	var platformProbes *operatorapi.HealthProbesSpec
+	if pl, _ := platform.GetActivePlatform(ctx, d.c, workflow.Namespace); pl != nil {
+		platformProbes = pl.Spec.HealthProbes
+	}
+	if !workflow.Spec.PodTemplate.HealthProbes.IsAdaptiveStartup(platformProbes) {
+		workflow.Status.ObservedStartupSeconds = 0
+		return
+	}
+	observed, err := kubeutil.GetDeploymentObservedStartupSeconds(ctx, d.c, deployment, operatorapi.DefaultContainerName)
+	if err != nil {
+		klog.V(log.I).InfoS("Unable to observe the workflow startup time", "workflow", workflow.Name, "error", err)
+		return
+	}
+	if observed > workflow.Status.ObservedStartupSeconds*(100+adaptiveStartupHysteresisPercent)/100 {
+		workflow.Status.ObservedStartupSeconds = observed
+	}
+}
+
 // GetDeploymentUnavailabilityMessage gets the replica failure reason.
 // MUST be called after checking that the Deployment is NOT available.
 // If there's no reason, the Deployment state has no apparent reason to be in failed state.
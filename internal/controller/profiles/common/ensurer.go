@@ -27,6 +27,7 @@ import (
 	"github.com/apache/incubator-kie-kogito-serverless-operator/log"
 	"k8s.io/klog/v2"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	eventingv1beta2 "knative.dev/eventing/pkg/apis/eventing/v1beta2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
@@ -232,6 +233,10 @@ func ensureObject(ctx context.Context, workflow *operatorapi.SonataFlow, visitor
 					return setWorkflowFinalizer(ctx, c, workflow)
 				}
 			}
+			if _, ok := object.(*eventingv1beta2.EventType); ok && workflow.Namespace != object.GetNamespace() {
+				// EventTypes live in the broker namespace, they're removed by the finalizer too
+				return setWorkflowFinalizer(ctx, c, workflow)
+			}
 			return controllerutil.SetControllerReference(workflow, object, c.Scheme())
 		}); err != nil {
 		return nil, result, err
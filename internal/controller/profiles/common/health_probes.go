@@ -0,0 +1,98 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package common
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/cfg"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles"
+)
+
+const (
+	// adaptiveStartupBudgetFactor is the margin applied to the observed startup time when deriving the startup probe budget.
+	adaptiveStartupBudgetFactor = 2
+	// adaptiveStartupMaxBudgetSeconds caps the derived startup probe budget, so that a pod ready long after its start
+	// doesn't grow the budget indefinitely.
+	adaptiveStartupMaxBudgetSeconds = 600
+	// adaptiveStartupHysteresisPercent is how much longer than the observed startup time a new startup must take to be observed.
+	adaptiveStartupHysteresisPercent = 20
+)
+
+// configureHealthProbes merges the operator default probes of the workflow container with the dev profile defaults,
+// the platform and the workflow tuning, in this order. The startup probe budget is then derived from the observed
+// startup time if the adaptive startup is enabled.
+// The probes defined in the workflow container are merged afterward, see defaultContainer.
+func configureHealthProbes(container *corev1.Container, workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) {
+	if profiles.IsDevProfile(workflow) {
+		healthThreshold := cfg.GetCfg().HealthFailureThresholdDevMode
+		container.LivenessProbe.FailureThreshold = healthThreshold
+		container.ReadinessProbe.FailureThreshold = healthThreshold
+		container.StartupProbe.FailureThreshold = healthThreshold
+	}
+	var platformProbes *operatorapi.HealthProbesSpec
+	if plf != nil {
+		platformProbes = plf.Spec.HealthProbes
+	}
+	for _, probes := range []*operatorapi.HealthProbesSpec{platformProbes, workflow.Spec.PodTemplate.HealthProbes} {
+		if probes == nil {
+			continue
+		}
+		tuneProbe(container.LivenessProbe, probes.Liveness)
+		tuneProbe(container.ReadinessProbe, probes.Readiness)
+		tuneProbe(container.StartupProbe, probes.Startup)
+	}
+	if workflow.Spec.PodTemplate.HealthProbes.IsAdaptiveStartup(platformProbes) {
+		adaptStartupProbe(container.StartupProbe, workflow.Status.ObservedStartupSeconds)
+	}
+}
+
+func tuneProbe(probe *corev1.Probe, tuning *operatorapi.ProbeTuningSpec) {
+	if tuning == nil {
+		return
+	}
+	if tuning.InitialDelaySeconds != nil {
+		probe.InitialDelaySeconds = *tuning.InitialDelaySeconds
+	}
+	if tuning.TimeoutSeconds != nil {
+		probe.TimeoutSeconds = *tuning.TimeoutSeconds
+	}
+	if tuning.PeriodSeconds != nil {
+		probe.PeriodSeconds = *tuning.PeriodSeconds
+	}
+	if tuning.FailureThreshold != nil {
+		probe.FailureThreshold = *tuning.FailureThreshold
+	}
+}
+
+// adaptStartupProbe raises the startup probe failure threshold so that the probe budget covers the observed startup time
+// with a margin. The budget is never lowered.
+func adaptStartupProbe(probe *corev1.Probe, observedStartupSeconds int32) {
+	if observedStartupSeconds <= 0 || probe.PeriodSeconds <= 0 {
+		return
+	}
+	budget := min(observedStartupSeconds*adaptiveStartupBudgetFactor, adaptiveStartupMaxBudgetSeconds) - probe.InitialDelaySeconds
+	// round up to the next probe period
+	threshold := (budget + probe.PeriodSeconds - 1) / probe.PeriodSeconds
+	if threshold > probe.FailureThreshold {
+		probe.FailureThreshold = threshold
+	}
+}
@@ -0,0 +1,147 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/test"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
+)
+
+func TestDeploymentCreator_HealthProbesTuningIsMerged(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	plf := test.GetBasePlatform()
+	plf.Spec.HealthProbes = &v1alpha08.HealthProbesSpec{
+		Startup:  &v1alpha08.ProbeTuningSpec{FailureThreshold: utils.Pint(20), PeriodSeconds: utils.Pint(5)},
+		Liveness: &v1alpha08.ProbeTuningSpec{TimeoutSeconds: utils.Pint(10)},
+	}
+	workflow.Spec.PodTemplate.HealthProbes = &v1alpha08.HealthProbesSpec{
+		Startup: &v1alpha08.ProbeTuningSpec{FailureThreshold: utils.Pint(30)},
+	}
+	workflow.Spec.PodTemplate.Container.ReadinessProbe = &corev1.Probe{SuccessThreshold: 2}
+
+	object, err := DeploymentCreator(workflow, plf)
+	assert.NoError(t, err)
+	container := object.(*appsv1.Deployment).Spec.Template.Spec.Containers[0]
+
+	// the workflow tuning takes precedence over the platform one, which takes precedence over the defaults
+	assert.Equal(t, int32(30), container.StartupProbe.FailureThreshold)
+	assert.Equal(t, int32(5), container.StartupProbe.PeriodSeconds)
+	assert.Equal(t, int32(healthStartedInitialDelaySeconds), container.StartupProbe.InitialDelaySeconds)
+	assert.Equal(t, int32(10), container.LivenessProbe.TimeoutSeconds)
+	assert.Equal(t, int32(healthStartedPeriodSeconds), container.LivenessProbe.PeriodSeconds)
+	// the workflow container probe is merged, not replacing the default handler
+	assert.Equal(t, int32(2), container.ReadinessProbe.SuccessThreshold)
+	assert.NotNil(t, container.ReadinessProbe.HTTPGet)
+	assert.Equal(t, int32(healthTimeoutSeconds), container.ReadinessProbe.TimeoutSeconds)
+}
+
+func TestDeploymentCreator_AdaptiveStartupBudget(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Spec.PodTemplate.HealthProbes = &v1alpha08.HealthProbesSpec{AdaptiveStartup: utils.Pbool(true)}
+
+	// nothing observed yet
+	object, err := DeploymentCreator(workflow, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(healthStartedFailureThreshold), object.(*appsv1.Deployment).Spec.Template.Spec.Containers[0].StartupProbe.FailureThreshold)
+
+	// (100s * 2 - 10s initial delay) / 15s period, rounded up
+	workflow.Status.ObservedStartupSeconds = 100
+	object, err = DeploymentCreator(workflow, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(13), object.(*appsv1.Deployment).Spec.Template.Spec.Containers[0].StartupProbe.FailureThreshold)
+
+	// the budget is capped
+	workflow.Status.ObservedStartupSeconds = 3600
+	object, err = DeploymentCreator(workflow, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(40), object.(*appsv1.Deployment).Spec.Template.Spec.Containers[0].StartupProbe.FailureThreshold)
+
+	// the budget is never lowered
+	workflow.Status.ObservedStartupSeconds = 10
+	object, err = DeploymentCreator(workflow, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(healthStartedFailureThreshold), object.(*appsv1.Deployment).Spec.Template.Spec.Containers[0].StartupProbe.FailureThreshold)
+
+	// disabled in the workflow even if enabled in the platform
+	plf := test.GetBasePlatform()
+	plf.Spec.HealthProbes = &v1alpha08.HealthProbesSpec{AdaptiveStartup: utils.Pbool(true)}
+	workflow.Spec.PodTemplate.HealthProbes.AdaptiveStartup = utils.Pbool(false)
+	workflow.Status.ObservedStartupSeconds = 100
+	object, err = DeploymentCreator(workflow, plf)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(healthStartedFailureThreshold), object.(*appsv1.Deployment).Spec.Template.Spec.Containers[0].StartupProbe.FailureThreshold)
+}
+
+func TestDeploymentHandler_ObserveStartupTime(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: workflow.Name, Namespace: workflow.Namespace},
+		Spec:       appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": workflow.Name}}},
+	}
+	started := time.Now().Add(-time.Hour)
+	newPod := func(name string, startup time.Duration) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: workflow.Namespace, Labels: map[string]string{"app": workflow.Name}},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(started.Add(startup))}},
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  v1alpha08.DefaultContainerName,
+					Ready: true,
+					State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: metav1.NewTime(started)}},
+				}},
+			},
+		}
+	}
+	pod := newPod("pod-1", 100*time.Second)
+	cli := test.NewSonataFlowClientBuilder().WithRuntimeObjects(workflow, pod).Build()
+	handler := &deploymentHandler{c: cli}
+	ctx := context.TODO()
+	rollout := func(name string, startup time.Duration) {
+		assert.NoError(t, cli.Delete(ctx, pod))
+		pod = newPod(name, startup)
+		assert.NoError(t, cli.Create(ctx, pod))
+		handler.observeStartupTime(ctx, workflow, deployment)
+	}
+
+	// only observed with the adaptive startup
+	handler.observeStartupTime(ctx, workflow, deployment)
+	assert.Zero(t, workflow.Status.ObservedStartupSeconds)
+	workflow.Spec.PodTemplate.HealthProbes = &v1alpha08.HealthProbesSpec{AdaptiveStartup: utils.Pbool(true)}
+	handler.observeStartupTime(ctx, workflow, deployment)
+	assert.Equal(t, int32(100), workflow.Status.ObservedStartupSeconds)
+
+	// a slightly longer startup doesn't roll out the workflow again, a shorter one doesn't lower the budget
+	rollout("pod-2", 110*time.Second)
+	assert.Equal(t, int32(100), workflow.Status.ObservedStartupSeconds)
+	rollout("pod-3", 50*time.Second)
+	assert.Equal(t, int32(100), workflow.Status.ObservedStartupSeconds)
+	rollout("pod-4", 150*time.Second)
+	assert.Equal(t, int32(150), workflow.Status.ObservedStartupSeconds)
+}
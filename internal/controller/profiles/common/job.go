@@ -0,0 +1,310 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api"
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/cfg"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/constants"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/log"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
+	kubeutil "github.com/apache/incubator-kie-kogito-serverless-operator/utils/kubernetes"
+)
+
+const (
+	jobRunnerContainerName = "runner"
+	jobInputVolumeName     = "job-input"
+	jobInputMountPath      = "/deployments/job"
+	jobInputFileName       = "input.json"
+	// jobResultMaxLength is the maximum size of a container termination message
+	jobResultMaxLength = 4096
+	// jobRunnerScript starts the workflow instance and waits for it to complete. The instance state is read from the
+	// Data Index when the workflow uses one, otherwise the instance is completed once the workflow doesn't find it
+	// anymore, failed when the process management addon reports an error. The final instance data, or the error, is
+	// reported in the termination message and the script exits non-zero if the instance didn't complete.
+	jobRunnerScript = `data='{}'
+if [ -f "${INPUT_FILE}" ]; then data="@${INPUT_FILE}"; fi
+report() {
+  echo "$2"
+  printf '%s' "$2" | head -c 4096 > /dev/termination-log
+  exit $1
+}
+response=$(curl --silent --show-error --fail-with-body -X POST -H 'Content-Type: application/json' -H 'Accept: application/json' -d "${data}" "${WORKFLOW_URL}" 2>&1) || report 1 "${response}"
+id=$(echo "${response}" | sed -n 's/^{ *"id" *: *"\([^"]*\)".*/\1/p')
+[ -n "${id}" ] || report 1 "${response}"
+running=false
+while true; do
+  if [ -n "${DATA_INDEX_URL}" ]; then
+    instance=$(curl --silent --show-error --fail-with-body -X POST -H 'Content-Type: application/json' -H 'Accept: application/json' \
+      -d "{\"query\":\"{ProcessInstances(where:{id:{equal:\\\"${id}\\\"}}){state error{message} variables}}\"}" "${DATA_INDEX_URL}/graphql" 2>&1) || report 1 "${instance}"
+    state=$(echo "${instance}" | sed -n 's/^{ *"data" *: *{ *"ProcessInstances" *: *\[ *{ *"state" *: *"\([A-Z]*\)".*/\1/p')
+    case "${state}" in
+      COMPLETED) report 0 "${instance}" ;;
+      ABORTED|ERROR) report 1 "${instance}" ;;
+    esac
+  else
+    code=$(curl --silent --output /dev/null --write-out '%{http_code}' -H 'Accept: application/json' "${WORKFLOW_URL}/${id}")
+    case "${code}" in
+      200)
+        running=true
+        error=$(curl --silent --fail -H 'Accept: application/json' "${WORKFLOW_URL%/*}/management/processes/${WORKFLOW_URL##*/}/instances/${id}/error") && report 1 "${error}" ;;
+      # the instance data seen while running isn't the final one, only the synchronous response is
+      404) if ${running}; then report 0 "{\"id\":\"${id}\"}"; else report 0 "${response}"; fi ;;
+      *) report 1 "Unexpected HTTP status ${code} reading the workflow instance ${id}" ;;
+    esac
+  fi
+  sleep 5
+done
+`
+)
+
+var _ ObjectEnsurerWithPlatform = &jobEnsurer{}
+
+// NewJobEnsurer creates an ObjectEnsurerWithPlatform that runs a single workflow instance to completion in a Kubernetes Job.
+// The Job is replaced when the workflow changes, a finished Job removed after its TTL isn't run again.
+// The Job progress is kept in the workflow status, the caller must persist it.
+func NewJobEnsurer(c client.Client) ObjectEnsurerWithPlatform {
+	return &jobEnsurer{c: c}
+}
+
+type jobEnsurer struct {
+	c client.Client
+}
+
+func (j *jobEnsurer) Ensure(ctx context.Context, workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform, visitors ...MutateVisitor) (client.Object, controllerutil.OperationResult, error) {
+	job, err := JobCreator(workflow, pl, visitors...)
+	if err != nil {
+		return nil, controllerutil.OperationResultNone, err
+	}
+	hash := job.Annotations[templateHashAnnotation]
+	existing := &batchv1.Job{}
+	if err = j.c.Get(ctx, client.ObjectKeyFromObject(job), existing); err == nil {
+		if existing.Annotations[templateHashAnnotation] == hash {
+			return existing, controllerutil.OperationResultNone, nil
+		}
+		// the Job template is immutable, the instance must be run again in a new Job
+		klog.V(log.I).InfoS("Replacing workflow Job", "workflow", workflow.Name, "job", existing.Name)
+		if err = j.c.Delete(ctx, existing, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			return nil, controllerutil.OperationResultNone, err
+		}
+		workflow.Status.Job = nil
+	} else if !errors.IsNotFound(err) {
+		return nil, controllerutil.OperationResultNone, err
+	} else if workflow.Status.Job.IsFinished() && workflow.Status.Job.TemplateHash == hash {
+		// the finished Job was removed after its TTL
+		return nil, controllerutil.OperationResultNone, nil
+	}
+
+	if err = controllerutil.SetControllerReference(workflow, job, j.c.Scheme()); err != nil {
+		return nil, controllerutil.OperationResultNone, err
+	}
+	if err = j.c.Create(ctx, job); err != nil {
+		return nil, controllerutil.OperationResultNone, err
+	}
+	klog.V(log.I).InfoS("Object operation finalized", "result", controllerutil.OperationResultCreated, "kind", "Job", "name", job.Name, "namespace", job.Namespace)
+	workflow.Status.Job = &operatorapi.JobStatus{Name: job.Name, TemplateHash: hash, Phase: operatorapi.JobPhaseRunning}
+	return job, controllerutil.OperationResultCreated, nil
+}
+
+// JobCreator creates the Job running a single workflow instance to completion. The workflow pod is built as for the
+// "kubernetes" deployment model, the given Deployment visitors included, and the workflow container is run as a sidecar
+// of the runner container starting the instance. Requires Kubernetes 1.29 or later.
+func JobCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform, visitors ...MutateVisitor) (*batchv1.Job, error) {
+	object, err := DeploymentCreator(workflow, plf)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range visitors {
+		if err = v(object)(); err != nil {
+			return nil, err
+		}
+	}
+	deployment := object.(*appsv1.Deployment)
+	podSpec := deployment.Spec.Template.Spec
+	flowContainer, idx := kubeutil.GetContainerByName(operatorapi.DefaultContainerName, &podSpec)
+	if flowContainer == nil {
+		return nil, fmt.Errorf("workflow container not found in the pod of the workflow %s", workflow.Name)
+	}
+	// the workflow container is restarted as a native sidecar, terminated once the runner container exits
+	restartAlways := corev1.ContainerRestartPolicyAlways
+	sidecar := *flowContainer
+	sidecar.RestartPolicy = &restartAlways
+	podSpec.Containers = append(podSpec.Containers[:idx], podSpec.Containers[idx+1:]...)
+	podSpec.InitContainers = append(podSpec.InitContainers, sidecar)
+	podSpec.RestartPolicy = corev1.RestartPolicyNever
+	podSpec.Containers = append(podSpec.Containers, jobRunnerContainer(workflow))
+	if volume := jobInputVolume(workflow); volume != nil {
+		kubeutil.AddOrReplaceVolume(&podSpec, *volume)
+	}
+
+	spec := workflow.Spec.PodTemplate.Job
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workflow.Name,
+			Namespace: workflow.Namespace,
+			Labels:    deployment.Labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: utils.Pint(spec.GetBackoffLimit()),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: deployment.Spec.Template.ObjectMeta,
+				Spec:       podSpec,
+			},
+		},
+	}
+	if spec != nil {
+		job.Spec.TTLSecondsAfterFinished = spec.TTLSecondsAfterFinished
+		job.Spec.ActiveDeadlineSeconds = spec.ActiveDeadlineSeconds
+	}
+	hash, err := json.Marshal(job.Spec)
+	if err != nil {
+		return nil, err
+	}
+	job.Annotations = map[string]string{templateHashAnnotation: fmt.Sprintf("%08x", crc32.ChecksumIEEE(hash))}
+	return job, nil
+}
+
+func jobRunnerContainer(workflow *operatorapi.SonataFlow) corev1.Container {
+	runner := corev1.Container{
+		Name:    jobRunnerContainerName,
+		Image:   cfg.GetCfg().WorkflowTriggerImageTag,
+		Command: []string{"/bin/sh", "-c", jobRunnerScript},
+		Env: []corev1.EnvVar{
+			{Name: "WORKFLOW_URL", Value: fmt.Sprintf("%s://localhost:%d/%s", constants.DefaultHTTPProtocol, constants.DefaultHTTPWorkflowPortInt, workflow.Name)},
+			{Name: "INPUT_FILE", Value: jobInputMountPath + "/" + jobInputFileName},
+		},
+		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+		SecurityContext:          kubeutil.SecurityDefaults(),
+	}
+	if services := workflow.Status.Services; services != nil && services.DataIndexRef != nil && len(services.DataIndexRef.Url) > 0 {
+		runner.Env = append(runner.Env, corev1.EnvVar{Name: "DATA_INDEX_URL", Value: services.DataIndexRef.Url})
+	}
+	if jobInputVolume(workflow) != nil {
+		runner.VolumeMounts = []corev1.VolumeMount{kubeutil.VolumeMount(jobInputVolumeName, true, jobInputMountPath)}
+	}
+	return runner
+}
+
+// jobInputVolume projects the key holding the workflow instance input, nil if the instance has no input.
+func jobInputVolume(workflow *operatorapi.SonataFlow) *corev1.Volume {
+	spec := workflow.Spec.PodTemplate.Job
+	if spec == nil || spec.Input == nil {
+		return nil
+	}
+	if ref := spec.Input.ConfigMapKeyRef; ref != nil {
+		return &corev1.Volume{Name: jobInputVolumeName, VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: ref.LocalObjectReference,
+			Items:                []corev1.KeyToPath{{Key: ref.Key, Path: jobInputFileName}},
+		}}}
+	}
+	if ref := spec.Input.SecretKeyRef; ref != nil {
+		return &corev1.Volume{Name: jobInputVolumeName, VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+			SecretName: ref.Name,
+			Items:      []corev1.KeyToPath{{Key: ref.Key, Path: jobInputFileName}},
+		}}}
+	}
+	return nil
+}
+
+// syncJobStatus updates the workflow status aligned with the Job running the workflow instance.
+func (d *deploymentHandler) syncJobStatus(ctx context.Context, workflow *operatorapi.SonataFlow) (ctrl.Result, error) {
+	status := workflow.Status.Job
+	if status == nil {
+		workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.WaitingForDeploymentReason, "")
+		return ctrl.Result{RequeueAfter: constants.RequeueAfterFollowDeployment, Requeue: true}, nil
+	}
+	job := &batchv1.Job{}
+	if err := d.c.Get(ctx, client.ObjectKey{Namespace: workflow.Namespace, Name: status.Name}, job); err != nil {
+		if errors.IsNotFound(err) && status.IsFinished() {
+			return ctrl.Result{}, nil
+		}
+		workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.DeploymentUnavailableReason, "Couldn't find the workflow Job")
+		return ctrl.Result{RequeueAfter: constants.RequeueAfterFailure}, client.IgnoreNotFound(err)
+	}
+	status.StartTime = job.Status.StartTime
+
+	if condition := kubeutil.GetJobFinishedCondition(job); condition != nil {
+		result, err := d.getJobResult(ctx, job)
+		if err != nil {
+			return ctrl.Result{RequeueAfter: constants.RequeueAfterFailure}, err
+		}
+		status.Result = result
+		status.CompletionTime = &condition.LastTransitionTime
+		if condition.Type == batchv1.JobComplete {
+			status.Phase = operatorapi.JobPhaseSucceeded
+			workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.JobCompletedReason, "The workflow instance completed")
+			klog.V(log.I).InfoS("Workflow Job completed", "workflow", workflow.Name)
+		} else {
+			status.Phase = operatorapi.JobPhaseFailed
+			workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.JobFailedReason, condition.Message)
+			klog.V(log.I).InfoS("Workflow Job failed", "workflow", workflow.Name, "reason", condition.Message)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	status.Phase = operatorapi.JobPhaseRunning
+	if job.Status.Active > 0 {
+		workflow.Status.Manager().MarkTrue(api.RunningConditionType)
+		return ctrl.Result{RequeueAfter: constants.RequeueAfterIsRunning}, nil
+	}
+	workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.WaitingForDeploymentReason, "")
+	return ctrl.Result{RequeueAfter: constants.RequeueAfterFollowDeployment, Requeue: true}, nil
+}
+
+// getJobResult gets the result reported by the runner container of the last finished Job pod.
+func (d *deploymentHandler) getJobResult(ctx context.Context, job *batchv1.Job) (string, error) {
+	pods := &corev1.PodList{}
+	if err := d.c.List(ctx, pods, client.InNamespace(job.Namespace), client.MatchingLabels{batchv1.JobNameLabel: job.Name}); err != nil {
+		return "", err
+	}
+	var last *corev1.ContainerStateTerminated
+	for _, pod := range pods.Items {
+		for _, container := range pod.Status.ContainerStatuses {
+			terminated := container.State.Terminated
+			if container.Name != jobRunnerContainerName || terminated == nil {
+				continue
+			}
+			if last == nil || terminated.FinishedAt.After(last.FinishedAt.Time) {
+				last = terminated
+			}
+		}
+	}
+	if last == nil {
+		return "", nil
+	}
+	if len(last.Message) > jobResultMaxLength {
+		return last.Message[:jobResultMaxLength], nil
+	}
+	return last.Message, nil
+}
@@ -0,0 +1,131 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/test"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
+)
+
+func getJobWorkflow(t *testing.T) *v1alpha08.SonataFlow {
+	workflow := test.GetBaseSonataFlowWithPreviewProfile(t.Name())
+	workflow.Spec.PodTemplate.DeploymentModel = v1alpha08.JobDeploymentModel
+	workflow.Spec.PodTemplate.Job = &v1alpha08.JobDeploymentSpec{
+		Input: &v1alpha08.JobInputSource{
+			ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "batch-input"}, Key: "data.json"},
+		},
+		TTLSecondsAfterFinished: utils.Pint(60),
+	}
+	return workflow
+}
+
+func TestJobCreator(t *testing.T) {
+	workflow := getJobWorkflow(t)
+	job, err := JobCreator(workflow, nil)
+	assert.NoError(t, err)
+
+	pod := job.Spec.Template.Spec
+	assert.Equal(t, corev1.RestartPolicyNever, pod.RestartPolicy)
+	assert.Equal(t, int32(0), *job.Spec.BackoffLimit)
+	assert.Equal(t, int32(60), *job.Spec.TTLSecondsAfterFinished)
+	assert.Len(t, pod.InitContainers, 1)
+	assert.Equal(t, v1alpha08.DefaultContainerName, pod.InitContainers[0].Name)
+	assert.Equal(t, corev1.ContainerRestartPolicyAlways, *pod.InitContainers[0].RestartPolicy)
+	assert.Len(t, pod.Containers, 1)
+	assert.Equal(t, jobRunnerContainerName, pod.Containers[0].Name)
+	assert.Contains(t, pod.Containers[0].Env, corev1.EnvVar{Name: "WORKFLOW_URL", Value: "http://localhost:8080/" + workflow.Name})
+	assert.Equal(t, jobInputMountPath, pod.Containers[0].VolumeMounts[0].MountPath)
+	volume := pod.Volumes[len(pod.Volumes)-1]
+	assert.Equal(t, "batch-input", volume.ConfigMap.Name)
+	assert.Equal(t, []corev1.KeyToPath{{Key: "data.json", Path: jobInputFileName}}, volume.ConfigMap.Items)
+	assert.NotEmpty(t, job.Annotations[templateHashAnnotation])
+	for _, env := range pod.Containers[0].Env {
+		assert.NotEqual(t, "DATA_INDEX_URL", env.Name)
+	}
+
+	// the instance state is read from the Data Index used by the workflow
+	workflow.Status.Services = &v1alpha08.PlatformServicesStatus{DataIndexRef: &v1alpha08.PlatformServiceRefStatus{Url: "http://data-index.default"}}
+	job, err = JobCreator(workflow, nil)
+	assert.NoError(t, err)
+	assert.Contains(t, job.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: "DATA_INDEX_URL", Value: "http://data-index.default"})
+}
+
+func TestJobEnsurer_RunsInstanceOnce(t *testing.T) {
+	workflow := getJobWorkflow(t)
+	cli := test.NewSonataFlowClientBuilder().WithRuntimeObjects(workflow).Build()
+	ensurer := NewJobEnsurer(cli)
+
+	object, result, err := ensurer.Ensure(context.TODO(), workflow, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "created", string(result))
+	job := object.(*batchv1.Job)
+	assert.Equal(t, v1alpha08.JobPhaseRunning, workflow.Status.Job.Phase)
+
+	// the Job completes and the runner reports the instance data
+	now := metav1.Now()
+	job.Status.StartTime = &now
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue, LastTransitionTime: now}}
+	assert.NoError(t, cli.Status().Update(context.TODO(), job))
+	runnerPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: job.Name + "-abcde", Namespace: job.Namespace, Labels: map[string]string{batchv1.JobNameLabel: job.Name}},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+			Name:  jobRunnerContainerName,
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: `{"id":"1","workflowdata":{"done":true}}`, FinishedAt: now}},
+		}}},
+	}
+	assert.NoError(t, cli.Create(context.TODO(), runnerPod))
+
+	_, err = DeploymentManager(cli).SyncDeploymentStatus(context.TODO(), workflow)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha08.JobPhaseSucceeded, workflow.Status.Job.Phase)
+	assert.Equal(t, `{"id":"1","workflowdata":{"done":true}}`, workflow.Status.Job.Result)
+	assert.Equal(t, api.JobCompletedReason, workflow.Status.GetCondition(api.RunningConditionType).Reason)
+
+	// the finished Job is removed after its TTL and must not run the instance again
+	assert.NoError(t, cli.Delete(context.TODO(), job))
+	object, result, err = ensurer.Ensure(context.TODO(), workflow, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, object)
+	assert.Equal(t, "unchanged", string(result))
+	assert.True(t, errors.IsNotFound(cli.Get(context.TODO(), client.ObjectKeyFromObject(job), &batchv1.Job{})))
+	_, err = DeploymentManager(cli).SyncDeploymentStatus(context.TODO(), workflow)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha08.JobPhaseSucceeded, workflow.Status.Job.Phase)
+
+	// changing the workflow runs a new instance
+	workflow.Spec.PodTemplate.Job.Input = nil
+	object, result, err = ensurer.Ensure(context.TODO(), workflow, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "created", string(result))
+	assert.NotNil(t, object)
+	assert.Equal(t, v1alpha08.JobPhaseRunning, workflow.Status.Job.Phase)
+}
@@ -33,6 +33,7 @@ var _ KnativeEventingHandler = &knativeObjectManager{}
 type knativeObjectManager struct {
 	sinkBinding ObjectEnsurerWithPlatform
 	trigger     ObjectsEnsurerWithPlatform
+	eventType   ObjectsEnsurerWithPlatform
 	platform    *operatorapi.SonataFlowPlatform
 	*StateSupport
 }
@@ -41,6 +42,7 @@ func NewKnativeEventingHandler(support *StateSupport, pl *operatorapi.SonataFlow
 	return &knativeObjectManager{
 		sinkBinding:  NewObjectEnsurerWithPlatform(support.C, SinkBindingCreator),
 		trigger:      NewObjectsEnsurerWithPlatform(support.C, TriggersCreator),
+		eventType:    NewObjectsEnsurerWithPlatform(support.C, EventTypesCreator),
 		platform:     pl,
 		StateSupport: support,
 	}
@@ -76,6 +78,14 @@ func (k knativeObjectManager) Ensure(ctx context.Context, workflow *operatorapi.
 			}
 			objs = append(objs, trigger.Object)
 		}
+
+		eventTypes := k.eventType.Ensure(ctx, workflow, k.platform)
+		for _, eventType := range eventTypes {
+			if eventType.Error != nil {
+				return objs, eventType.Error
+			}
+			objs = append(objs, eventType.Object)
+		}
 	}
 	return objs, nil
 }
@@ -0,0 +1,221 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"knative.dev/serving/pkg/apis/autoscaling"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/constants"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/log"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
+)
+
+// knativeAutoscalingAnnotations are the revision template annotations managed from the workflow autoscaling.
+var knativeAutoscalingAnnotations = []string{
+	autoscaling.MinScaleAnnotationKey,
+	autoscaling.MaxScaleAnnotationKey,
+	autoscaling.InitialScaleAnnotationKey,
+	autoscaling.MetricAnnotationKey,
+	autoscaling.TargetAnnotationKey,
+	autoscaling.TargetUtilizationPercentageKey,
+	autoscaling.ScaleDownDelayAnnotationKey,
+}
+
+// kServiceAutoscalingAnnotations translates the workflow autoscaling to the Knative revision template annotations.
+func kServiceAutoscalingAnnotations(spec *operatorapi.KnativeAutoscalingSpec) map[string]string {
+	annotations := map[string]string{}
+	setInt := func(key string, value *int32) {
+		if value != nil {
+			annotations[key] = strconv.Itoa(int(*value))
+		}
+	}
+	setInt(autoscaling.MinScaleAnnotationKey, spec.MinScale)
+	setInt(autoscaling.MaxScaleAnnotationKey, spec.MaxScale)
+	setInt(autoscaling.InitialScaleAnnotationKey, spec.InitialScale)
+	setInt(autoscaling.TargetAnnotationKey, spec.Target)
+	setInt(autoscaling.TargetUtilizationPercentageKey, spec.TargetUtilizationPercentage)
+	if len(spec.Metric) > 0 {
+		annotations[autoscaling.MetricAnnotationKey] = string(spec.Metric)
+	}
+	if spec.ScaleDownDelay != nil {
+		annotations[autoscaling.ScaleDownDelayAnnotationKey] = spec.ScaleDownDelay.Duration.String()
+	}
+	return annotations
+}
+
+// setKServiceAutoscaling applies the workflow autoscaling to the Knative Service revision template.
+// The managed autoscaling annotations and the container concurrency are always replaced, so that they're reset to the
+// Knative defaults once removed from the workflow.
+func setKServiceAutoscaling(workflow *operatorapi.SonataFlow, ksvc *servingv1.Service) {
+	template := &ksvc.Spec.Template
+	for _, key := range knativeAutoscalingAnnotations {
+		delete(template.Annotations, key)
+	}
+	template.Spec.ContainerConcurrency = nil
+	spec := workflow.Spec.PodTemplate.Autoscaling
+	if spec == nil {
+		return
+	}
+	annotations := kServiceAutoscalingAnnotations(spec)
+	if len(annotations) > 0 && template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	for key, value := range annotations {
+		template.Annotations[key] = value
+	}
+	template.Spec.ContainerConcurrency = spec.ContainerConcurrency
+}
+
+// kServiceTraffic computes the Knative Service traffic block for the given workflow.
+// A nil slice means that Knative routes all the traffic to the latest ready revision.
+func kServiceTraffic(workflow *operatorapi.SonataFlow) []servingv1.TrafficTarget {
+	traffic := workflow.Spec.PodTemplate.Traffic
+	if traffic == nil {
+		return nil
+	}
+	if traffic.Canary != nil {
+		return canaryTraffic(traffic.Canary, workflow.Status.Canary)
+	}
+	var targets []servingv1.TrafficTarget
+	for _, target := range traffic.Targets {
+		t := servingv1.TrafficTarget{
+			Tag:     target.Tag,
+			Percent: target.Percent,
+		}
+		if len(target.RevisionName) > 0 {
+			t.RevisionName = target.RevisionName
+			t.LatestRevision = utils.Pbool(false)
+		} else {
+			t.LatestRevision = utils.Pbool(true)
+		}
+		targets = append(targets, t)
+	}
+	return targets
+}
+
+func canaryTraffic(canary *operatorapi.KnativeCanarySpec, status *operatorapi.KnativeCanaryStatus) []servingv1.TrafficTarget {
+	// first rollout, there's nothing to compare the new revision with
+	if status == nil || len(status.StableRevision) == 0 {
+		return nil
+	}
+	canaryPercent := status.Percent
+	stablePercent := 100 - canaryPercent
+	return []servingv1.TrafficTarget{
+		{
+			RevisionName:   status.StableRevision,
+			LatestRevision: utils.Pbool(false),
+			Percent:        &stablePercent,
+		},
+		{
+			Tag:            canary.GetTag(),
+			LatestRevision: utils.Pbool(true),
+			Percent:        &canaryPercent,
+		},
+	}
+}
+
+// TrafficRevisions returns the revisions the workflow routes traffic to, these must not be cleaned up.
+func TrafficRevisions(workflow *operatorapi.SonataFlow) []string {
+	var revisions []string
+	for _, target := range kServiceTraffic(workflow) {
+		if len(target.RevisionName) > 0 {
+			revisions = append(revisions, target.RevisionName)
+		}
+	}
+	if workflow.Status.Canary != nil && len(workflow.Status.Canary.CanaryRevision) > 0 {
+		revisions = append(revisions, workflow.Status.Canary.CanaryRevision)
+	}
+	return revisions
+}
+
+// SyncKnativeCanary promotes the latest workflow revision while it stays ready, as defined by the workflow canary policy.
+// The canary progress is kept in the workflow status, the caller must persist it.
+// Returns how long to wait before the next promotion step, zero if there's nothing to promote.
+func SyncKnativeCanary(ctx context.Context, c client.Client, workflow *operatorapi.SonataFlow) (time.Duration, error) {
+	if !workflow.IsKnativeDeployment() || workflow.Spec.PodTemplate.Traffic == nil || workflow.Spec.PodTemplate.Traffic.Canary == nil {
+		workflow.Status.Canary = nil
+		return 0, nil
+	}
+	ksvc := &servingv1.Service{}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(workflow), ksvc); err != nil {
+		if errors.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	canary := workflow.Spec.PodTemplate.Traffic.Canary
+	status := workflow.Status.Canary
+	if status == nil {
+		status = &operatorapi.KnativeCanaryStatus{}
+		workflow.Status.Canary = status
+	}
+	latestCreated := ksvc.Status.LatestCreatedRevisionName
+	latestReady := ksvc.Status.LatestReadyRevisionName
+
+	if len(status.StableRevision) == 0 {
+		// the first ready revision becomes the stable one
+		status.StableRevision = latestReady
+		return 0, nil
+	}
+	if len(latestCreated) == 0 || latestCreated == status.StableRevision {
+		status.CanaryRevision = ""
+		status.Percent = 0
+		status.LastStepTime = nil
+		return 0, nil
+	}
+	if latestCreated != status.CanaryRevision {
+		klog.V(log.I).InfoS("Starting canary release", "workflow", workflow.Name, "stable", status.StableRevision, "canary", latestCreated)
+		status.CanaryRevision = latestCreated
+		status.Percent = 0
+		status.LastStepTime = nil
+	}
+	if latestReady != latestCreated {
+		// the canary doesn't receive more traffic until it's ready
+		return constants.RequeueAfterFollowDeployment, nil
+	}
+
+	interval := canary.GetStepInterval().Duration
+	if status.LastStepTime != nil {
+		if wait := time.Until(status.LastStepTime.Add(interval)); wait > 0 {
+			return wait, nil
+		}
+	}
+	now := metav1.Now()
+	status.LastStepTime = &now
+	status.Percent += canary.GetStepPercent()
+	if status.Percent >= 100 {
+		klog.V(log.I).InfoS("Canary revision promoted", "workflow", workflow.Name, "revision", status.CanaryRevision)
+		status.StableRevision = status.CanaryRevision
+		status.CanaryRevision = ""
+		status.Percent = 0
+		status.LastStepTime = nil
+		return 0, nil
+	}
+	klog.V(log.I).InfoS("Canary traffic increased", "workflow", workflow.Name, "revision", status.CanaryRevision, "percent", status.Percent)
+	return interval, nil
+}
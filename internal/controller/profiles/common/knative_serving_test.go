@@ -0,0 +1,173 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/test"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
+)
+
+func TestKServiceCreatorWithTrafficTargets(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Spec.PodTemplate.DeploymentModel = v1alpha08.KnativeDeploymentModel
+	workflow.Spec.PodTemplate.Traffic = &v1alpha08.KnativeTrafficSpec{
+		Targets: []v1alpha08.KnativeTrafficTarget{
+			{RevisionName: "greeting-00001", Percent: utils.Pint64(90)},
+			{Tag: "candidate", Percent: utils.Pint64(10)},
+		},
+	}
+
+	object, err := KServiceCreator(workflow, nil)
+	assert.NoError(t, err)
+	traffic := object.(*servingv1.Service).Spec.Traffic
+	assert.Len(t, traffic, 2)
+	assert.Equal(t, "greeting-00001", traffic[0].RevisionName)
+	assert.False(t, *traffic[0].LatestRevision)
+	assert.Equal(t, int64(90), *traffic[0].Percent)
+	assert.Equal(t, "candidate", traffic[1].Tag)
+	assert.True(t, *traffic[1].LatestRevision)
+	assert.Equal(t, int64(10), *traffic[1].Percent)
+	assert.Equal(t, []string{"greeting-00001"}, TrafficRevisions(workflow))
+
+	// the workflow traffic takes precedence over the one set in the cluster
+	existing := object.(*servingv1.Service).DeepCopy()
+	existing.ResourceVersion = "1"
+	existing.Spec.Traffic = []servingv1.TrafficTarget{{LatestRevision: utils.Pbool(true), Percent: utils.Pint64(100)}}
+	assert.NoError(t, KServiceMutateVisitor(workflow, nil)(existing)())
+	assert.Equal(t, traffic, existing.Spec.Traffic)
+
+	// without workflow traffic, the one set in the cluster is kept
+	workflow.Spec.PodTemplate.Traffic = nil
+	existing.Spec.Traffic = []servingv1.TrafficTarget{{RevisionName: "greeting-00002", LatestRevision: utils.Pbool(false), Percent: utils.Pint64(100)}}
+	assert.NoError(t, KServiceMutateVisitor(workflow, nil)(existing)())
+	assert.Equal(t, "greeting-00002", existing.Spec.Traffic[0].RevisionName)
+}
+
+func TestKServiceAutoscaling(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Spec.PodTemplate.DeploymentModel = v1alpha08.KnativeDeploymentModel
+	workflow.Spec.PodTemplate.Autoscaling = &v1alpha08.KnativeAutoscalingSpec{
+		MinScale:             utils.Pint(1),
+		MaxScale:             utils.Pint(5),
+		Metric:               v1alpha08.KnativeRPSMetric,
+		Target:               utils.Pint(50),
+		ContainerConcurrency: utils.Pint64(10),
+		ScaleDownDelay:       &metav1.Duration{Duration: 15 * time.Minute},
+	}
+
+	object, err := KServiceCreator(workflow, nil)
+	assert.NoError(t, err)
+	template := object.(*servingv1.Service).Spec.Template
+	assert.Equal(t, map[string]string{
+		"autoscaling.knative.dev/min-scale":        "1",
+		"autoscaling.knative.dev/max-scale":        "5",
+		"autoscaling.knative.dev/metric":           "rps",
+		"autoscaling.knative.dev/target":           "50",
+		"autoscaling.knative.dev/scale-down-delay": "15m0s",
+	}, template.Annotations)
+	assert.Equal(t, int64(10), *template.Spec.ContainerConcurrency)
+
+	// the workflow autoscaling takes precedence over the annotations set in the cluster
+	existing := object.(*servingv1.Service).DeepCopy()
+	existing.ResourceVersion = "1"
+	existing.Spec.Template.Annotations["autoscaling.knative.dev/initial-scale"] = "3"
+	existing.Spec.Template.Annotations["autoscaling.knative.dev/max-scale"] = "100"
+	existing.Spec.Template.Annotations["custom"] = "kept"
+	workflow.Spec.PodTemplate.Autoscaling.MinScale = nil
+	assert.NoError(t, KServiceMutateVisitor(workflow, nil)(existing)())
+	assert.Equal(t, map[string]string{
+		"autoscaling.knative.dev/max-scale":        "5",
+		"autoscaling.knative.dev/metric":           "rps",
+		"autoscaling.knative.dev/target":           "50",
+		"autoscaling.knative.dev/scale-down-delay": "15m0s",
+		"custom": "kept",
+	}, existing.Spec.Template.Annotations)
+	assert.Equal(t, int64(10), *existing.Spec.Template.Spec.ContainerConcurrency)
+
+	// the managed autoscaling is reset once removed from the workflow
+	workflow.Spec.PodTemplate.Autoscaling = nil
+	assert.NoError(t, KServiceMutateVisitor(workflow, nil)(existing)())
+	assert.Equal(t, map[string]string{"custom": "kept"}, existing.Spec.Template.Annotations)
+	assert.Nil(t, existing.Spec.Template.Spec.ContainerConcurrency)
+}
+
+func TestSyncKnativeCanary(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Spec.PodTemplate.DeploymentModel = v1alpha08.KnativeDeploymentModel
+	workflow.Spec.PodTemplate.Traffic = &v1alpha08.KnativeTrafficSpec{
+		Canary: &v1alpha08.KnativeCanarySpec{StepPercent: utils.Pint64(50)},
+	}
+	ksvc := &servingv1.Service{ObjectMeta: metav1.ObjectMeta{Name: workflow.Name, Namespace: workflow.Namespace}}
+	ksvc.Status.LatestCreatedRevisionName = "rev-1"
+	ksvc.Status.LatestReadyRevisionName = "rev-1"
+	cli := test.NewSonataFlowClientBuilderWithKnative().WithRuntimeObjects(workflow, ksvc).Build()
+	ctx := context.TODO()
+
+	// first revision becomes the stable one
+	requeue, err := SyncKnativeCanary(ctx, cli, workflow)
+	assert.NoError(t, err)
+	assert.Zero(t, requeue)
+	assert.Equal(t, "rev-1", workflow.Status.Canary.StableRevision)
+
+	// a new revision that isn't ready yet gets no traffic
+	ksvc.Status.LatestCreatedRevisionName = "rev-2"
+	assert.NoError(t, cli.Update(ctx, ksvc))
+	_, err = SyncKnativeCanary(ctx, cli, workflow)
+	assert.NoError(t, err)
+	assert.Equal(t, "rev-2", workflow.Status.Canary.CanaryRevision)
+	assert.Equal(t, int64(0), workflow.Status.Canary.Percent)
+	traffic := kServiceTraffic(workflow)
+	assert.Len(t, traffic, 2)
+	assert.Equal(t, "rev-1", traffic[0].RevisionName)
+	assert.Equal(t, int64(100), *traffic[0].Percent)
+	assert.Equal(t, v1alpha08.DefaultCanaryTag, traffic[1].Tag)
+	assert.ElementsMatch(t, []string{"rev-1", "rev-2"}, TrafficRevisions(workflow))
+
+	// once ready, the canary receives the first step
+	ksvc.Status.LatestReadyRevisionName = "rev-2"
+	assert.NoError(t, cli.Update(ctx, ksvc))
+	requeue, err = SyncKnativeCanary(ctx, cli, workflow)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha08.DefaultCanaryStepInterval.Duration, requeue)
+	assert.Equal(t, int64(50), workflow.Status.Canary.Percent)
+
+	// the step interval hasn't elapsed yet
+	requeue, err = SyncKnativeCanary(ctx, cli, workflow)
+	assert.NoError(t, err)
+	assert.True(t, requeue > 0)
+	assert.Equal(t, int64(50), workflow.Status.Canary.Percent)
+
+	// last step promotes the canary
+	workflow.Status.Canary.LastStepTime = &metav1.Time{Time: time.Now().Add(-2 * time.Minute)}
+	_, err = SyncKnativeCanary(ctx, cli, workflow)
+	assert.NoError(t, err)
+	assert.Equal(t, "rev-2", workflow.Status.Canary.StableRevision)
+	assert.Empty(t, workflow.Status.Canary.CanaryRevision)
+	assert.Equal(t, int64(0), workflow.Status.Canary.Percent)
+}
@@ -24,21 +24,30 @@ import (
 	"maps"
 	"reflect"
 	"slices"
+	"time"
 
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api/metadata"
 	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/certmanager"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/discovery"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/gateway"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/knative"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/properties"
 	kubeutil "github.com/apache/incubator-kie-kogito-serverless-operator/utils/kubernetes"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/workflowproj"
 	"github.com/imdario/mergo"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 // ImageDeploymentMutateVisitor creates a visitor that mutates a vanilla Kubernetes Deployment to apply the given image in the DefaultContainerName container
@@ -61,6 +70,24 @@ func ImageDeploymentMutateVisitor(workflow *operatorapi.SonataFlow, image string
 	}
 }
 
+// BuildDeploymentMutateVisitor creates a visitor that records the completion of the given build in the pod template of a blue/green Deployment.
+// A rebuild usually pushes the same image tag, the annotation makes it a new revision rolled out in a new color.
+func BuildDeploymentMutateVisitor(workflow *operatorapi.SonataFlow, build *operatorapi.SonataFlowBuild) MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			if !workflow.IsBlueGreenDeployment() || build == nil || build.Status.CompletedAt == nil {
+				return nil
+			}
+			deployment := object.(*appsv1.Deployment)
+			if deployment.Spec.Template.Annotations == nil {
+				deployment.Spec.Template.Annotations = map[string]string{}
+			}
+			deployment.Spec.Template.Annotations[metadata.BuildCompletedAt] = build.Status.CompletedAt.UTC().Format(time.RFC3339)
+			return nil
+		}
+	}
+}
+
 // ImageKServiceMutateVisitor same as ImageDeploymentMutateVisitor for Knative Serving
 func ImageKServiceMutateVisitor(workflow *operatorapi.SonataFlow, image string) MutateVisitor {
 	return func(object client.Object) controllerutil.MutateFn {
@@ -112,6 +139,10 @@ func DeploymentMutateVisitor(workflow *operatorapi.SonataFlow, plf *operatorapi.
 				// values to be identical
 				src.Spec.Selector.MatchExpressions = dst.Spec.Selector.MatchExpressions
 			}
+			if workflow.IsEventDrivenAutoscalingEnabled() {
+				// the replicas are owned by the KEDA ScaledObject
+				src.Spec.Replicas = dst.Spec.Replicas
+			}
 			return EnsureDeployment(src, dst)
 		}
 	}
@@ -123,6 +154,8 @@ func EnsureDeployment(original *appsv1.Deployment, object *appsv1.Deployment) er
 	object.Spec.Selector = original.Spec.Selector
 	object.Labels = original.GetLabels()
 	object.Finalizers = original.Finalizers
+	// the merge below never clears a field, the spread constraints might have been removed or disabled
+	object.Spec.Template.Spec.TopologySpreadConstraints = original.Spec.Template.Spec.TopologySpreadConstraints
 
 	// Clean up the volumes, they are inherited from original, additional are added by other visitors
 	// However, the knative data (voulmes, volumes mounts) must be preserved
@@ -147,7 +180,16 @@ func KServiceMutateVisitor(workflow *operatorapi.SonataFlow, plf *operatorapi.So
 			if err != nil {
 				return err
 			}
-			return EnsureKService(original.(*servingv1.Service), object.(*servingv1.Service))
+			ksvc := object.(*servingv1.Service)
+			if err = EnsureKService(original.(*servingv1.Service), ksvc); err != nil {
+				return err
+			}
+			if workflow.Spec.PodTemplate.Traffic != nil {
+				// otherwise the traffic is left to Knative, or to whoever manages it in the cluster
+				ksvc.Spec.Traffic = original.(*servingv1.Service).Spec.Traffic
+			}
+			setKServiceAutoscaling(workflow, ksvc)
+			return nil
 		}
 	}
 }
@@ -179,12 +221,157 @@ func ServiceMutateVisitor(workflow *operatorapi.SonataFlow) MutateVisitor {
 				return err
 			}
 			object.(*corev1.Service).Spec.Ports = original.(*corev1.Service).Spec.Ports
+			object.(*corev1.Service).Spec.Selector = original.(*corev1.Service).Spec.Selector
 			object.(*corev1.Service).Labels = original.GetLabels()
 			return nil
 		}
 	}
 }
 
+// TLSServiceMutateVisitor adds the HTTPS port to the workflow Service if the workflow is served over HTTPS.
+func TLSServiceMutateVisitor(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			if certmanager.UsesTLS(workflow, plf) {
+				certmanager.AddHTTPSServicePort(object.(*corev1.Service))
+			}
+			return nil
+		}
+	}
+}
+
+// CertificateMutateVisitor guarantees the state of the cert-manager Certificate. Since the object is unstructured,
+// the whole spec is overridden.
+func CertificateMutateVisitor(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			original, err := CertificateCreator(workflow, plf)
+			if err != nil || original == nil {
+				return err
+			}
+			certificate := object.(*unstructured.Unstructured)
+			certificate.SetLabels(original.GetLabels())
+			certificate.Object["spec"] = original.(*unstructured.Unstructured).Object["spec"]
+			return nil
+		}
+	}
+}
+
+// IngressMutateVisitor guarantees the state of the workflow Ingress
+func IngressMutateVisitor(workflow *operatorapi.SonataFlow) MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			if kubeutil.IsObjectNew(object) {
+				return nil
+			}
+			ingress := object.(*networkingv1.Ingress)
+			ingress.Labels = workflowproj.GetMergedLabels(workflow)
+			kubeutil.SetIngressSpec(ingress, workflow.Spec.Exposure, workflow.Name, defaultHTTPServicePort)
+			return nil
+		}
+	}
+}
+
+func HTTPRouteMutateVisitor(workflow *operatorapi.SonataFlow) MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			if kubeutil.IsObjectNew(object) {
+				return nil
+			}
+			route := object.(*gwapi.HTTPRoute)
+			route.Labels = workflowproj.GetMergedLabels(workflow)
+			gateway.SetHTTPRouteSpec(route, workflow.Spec.HTTPRoute, workflow.Name, defaultHTTPServicePort)
+			return nil
+		}
+	}
+}
+
+func PodDisruptionBudgetMutateVisitor(workflow *operatorapi.SonataFlow) MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			if kubeutil.IsObjectNew(object) {
+				return nil
+			}
+			original, err := PodDisruptionBudgetCreator(workflow)
+			if err != nil || original == nil {
+				return err
+			}
+			pdb := object.(*policyv1.PodDisruptionBudget)
+			pdb.Labels = original.GetLabels()
+			pdb.Spec.MinAvailable = original.(*policyv1.PodDisruptionBudget).Spec.MinAvailable
+			pdb.Spec.MaxUnavailable = original.(*policyv1.PodDisruptionBudget).Spec.MaxUnavailable
+			return nil
+		}
+	}
+}
+
+// CronJobMutateVisitor guarantees the schedule of the CronJob starting the workflow and the trigger it runs.
+func CronJobMutateVisitor(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			if kubeutil.IsObjectNew(object) {
+				return nil
+			}
+			original, err := CronJobCreator(workflow, plf)
+			if err != nil || original == nil {
+				return err
+			}
+			cronJob := object.(*batchv1.CronJob)
+			originalCronJob := original.(*batchv1.CronJob)
+			cronJob.Labels = original.GetLabels()
+			cronJob.Spec.Schedule = originalCronJob.Spec.Schedule
+			cronJob.Spec.TimeZone = originalCronJob.Spec.TimeZone
+			cronJob.Spec.Suspend = originalCronJob.Spec.Suspend
+			// the trigger container is only overridden to keep the defaults set by the cluster in the Job template
+			containers := cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers
+			if len(containers) != 1 || containers[0].Name != cronTriggerContainerName {
+				cronJob.Spec.JobTemplate = originalCronJob.Spec.JobTemplate
+				return nil
+			}
+			originalContainer := originalCronJob.Spec.JobTemplate.Spec.Template.Spec.Containers[0]
+			containers[0].Image = originalContainer.Image
+			containers[0].Command = originalContainer.Command
+			containers[0].Args = originalContainer.Args
+			containers[0].Env = originalContainer.Env
+			return nil
+		}
+	}
+}
+
+// ScaledObjectMutateVisitor guarantees the state of the KEDA ScaledObject. Since the object is unstructured,
+// its spec is always reapplied after being read from the cluster.
+func ScaledObjectMutateVisitor(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			original, err := ScaledObjectCreator(workflow, plf)
+			if err != nil || original == nil {
+				return err
+			}
+			scaledObject := object.(*unstructured.Unstructured)
+			scaledObject.SetLabels(original.GetLabels())
+			scaledObject.Object["spec"] = original.(*unstructured.Unstructured).Object["spec"]
+			return nil
+		}
+	}
+}
+
+// NetworkPolicyMutateVisitor guarantees the state of the workflow NetworkPolicy. The policy is always recomputed, so that the
+// services discovered in the given managed properties are allowed as soon as the discovery results change.
+func NetworkPolicyMutateVisitor(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform, managedPropsCM *corev1.ConfigMap) MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			traffic, err := workflowNetworkPolicyTraffic(workflow, plf, managedPropsCM.Data[workflowproj.GetManagedPropertiesFileName(workflow)])
+			if err != nil {
+				return err
+			}
+			policy := object.(*networkingv1.NetworkPolicy)
+			policy.Labels = workflowproj.GetMergedLabels(workflow)
+			kubeutil.SetNetworkPolicySpec(policy, traffic)
+			return nil
+		}
+	}
+}
+
 func ManagedPropertiesMutateVisitor(ctx context.Context, catalog discovery.ServiceCatalog,
 	workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform, userProps *corev1.ConfigMap) MutateVisitor {
 	return func(object client.Object) controllerutil.MutateFn {
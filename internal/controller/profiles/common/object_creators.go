@@ -23,6 +23,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles"
 
@@ -31,25 +32,37 @@ import (
 	cncfmodel "github.com/serverlessworkflow/sdk-go/v2/model"
 
 	"github.com/imdario/mergo"
+	magicproperties "github.com/magiconair/properties"
 	prometheus "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/tracker"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1"
 
 	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/certmanager"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/cfg"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/gateway"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/kafka"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/keda"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/knative"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/constants"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/persistence"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/properties"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/security"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/variables"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/workflowdef"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
 	kubeutil "github.com/apache/incubator-kie-kogito-serverless-operator/utils/kubernetes"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/utils/openshift"
@@ -61,10 +74,14 @@ const (
 	knativeServiceKind       = "Service"
 	deploymentAPIVersion     = "apps/v1"
 	deploymentKind           = "Deployment"
+	jobAPIVersion            = "batch/v1"
+	jobKind                  = "Job"
 	k8sServiceAPIVersion     = "v1"
 	k8sServiceKind           = "Service"
 	k8sServicePortName       = "web"
 	metricsServicePortPath   = "/q/metrics"
+	// eventDataSchemaMetadata is the metadata of a workflow event holding the URI of its data schema
+	eventDataSchemaMetadata = "dataschema"
 )
 
 // ObjectCreator is the func that creates the initial reference object, if the object doesn't exist in the cluster, this one is created.
@@ -91,6 +108,26 @@ const (
 	healthStartedFailureThreshold    = 5
 	healthStartedPeriodSeconds       = 15
 	healthStartedInitialDelaySeconds = 10
+
+	// cronTriggerContainerName is the container of the Jobs starting the workflow on schedule
+	cronTriggerContainerName = "trigger"
+	cronTriggerComponent     = "serverless-workflow-trigger"
+	cronTriggerBackoffLimit  = 2
+	// cronTriggerScript posts a new instance of the workflow. When the workflow endpoints are secured with OIDC, the
+	// request carries an access token obtained with the client credentials of the workflow OIDC client.
+	cronTriggerScript = `set -- --silent --show-error --fail -X POST -H 'Content-Type: application/json' -H 'Accept: application/json' -d '{}'
+if [ -n "${OIDC_AUTH_SERVER_URL}" ] && [ -n "${` + security.QuarkusOIDCCredentialsSecretEnv + `}" ]; then
+  tokenEndpoint=$(curl --silent --show-error --fail "${OIDC_AUTH_SERVER_URL%/}/.well-known/openid-configuration" | \
+    tr ',' '\n' | sed -n 's/^[{ ]*"token_endpoint" *: *"\([^"]*\)".*/\1/p' | head -n 1)
+  [ -n "${tokenEndpoint}" ] || exit 1
+  token=$(curl --silent --show-error --fail -d grant_type=client_credentials --data-urlencode "client_id=${OIDC_CLIENT_ID}" \
+    --data-urlencode "client_secret=${` + security.QuarkusOIDCCredentialsSecretEnv + `}" "${tokenEndpoint}" | \
+    tr ',' '\n' | sed -n 's/^[{ ]*"access_token" *: *"\([^"]*\)".*/\1/p')
+  [ -n "${token}" ] || exit 1
+  set -- "$@" -H "Authorization: Bearer ${token}"
+fi
+exec curl "$@" "${WORKFLOW_URL}"
+`
 )
 
 // DeploymentCreator is an objectCreator for a base Kubernetes Deployments for profiles that need to deploy the workflow on a vanilla deployment.
@@ -125,11 +162,34 @@ func DeploymentCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.Sonata
 	if err != nil {
 		return nil, err
 	}
+	if certmanager.UsesTLS(workflow, plf) {
+		certmanager.ConfigurePodTLS(&deployment.Spec.Template.Spec, flowContainer, workflow.Name)
+	}
 	kubeutil.AddOrReplaceContainer(operatorapi.DefaultContainerName, *flowContainer, &deployment.Spec.Template.Spec)
+	if workflow.IsTopologySpreadRequired() && len(deployment.Spec.Template.Spec.TopologySpreadConstraints) == 0 {
+		deployment.Spec.Template.Spec.TopologySpreadConstraints = defaultTopologySpreadConstraints(workflow)
+	}
 
 	return deployment, nil
 }
 
+// defaultTopologySpreadConstraints spreads the workflow pods among the configured topology domains.
+func defaultTopologySpreadConstraints(workflow *operatorapi.SonataFlow) []corev1.TopologySpreadConstraint {
+	spread := workflow.Spec.PodTemplate.TopologySpread
+	var constraints []corev1.TopologySpreadConstraint
+	for _, key := range spread.GetTopologyKeys() {
+		constraints = append(constraints, corev1.TopologySpreadConstraint{
+			MaxSkew:           spread.GetMaxSkew(),
+			TopologyKey:       key,
+			WhenUnsatisfiable: spread.GetWhenUnsatisfiable(),
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: workflowproj.GetSelectorLabels(workflow),
+			},
+		})
+	}
+	return constraints
+}
+
 // KServiceCreator creates the default Knative Service object for SonataFlow instances. It's based on the default DeploymentCreator.
 func KServiceCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) (client.Object, error) {
 	lbl := workflowproj.GetMergedLabels(workflow)
@@ -161,6 +221,8 @@ func KServiceCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFl
 		return nil, err
 	}
 	kubeutil.AddOrReplaceContainer(operatorapi.DefaultContainerName, *flowContainer, &ksvc.Spec.Template.Spec.PodSpec)
+	ksvc.Spec.Traffic = kServiceTraffic(workflow)
+	setKServiceAutoscaling(workflow, ksvc)
 
 	return ksvc, nil
 }
@@ -217,6 +279,7 @@ func defaultContainer(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataF
 		},
 		SecurityContext: kubeutil.SecurityDefaults(),
 	}
+	configureHealthProbes(defaultFlowContainer, workflow, plf)
 	// Merge with flowContainer
 	if err := mergo.Merge(defaultFlowContainer, workflow.Spec.PodTemplate.Container.ToContainer(), mergo.WithOverride); err != nil {
 		return nil, err
@@ -229,7 +292,14 @@ func defaultContainer(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataF
 		if p := persistence.RetrieveConfiguration(workflow.Spec.Persistence, pper, workflow.Name); p != nil {
 			defaultFlowContainer = persistence.ConfigurePersistence(defaultFlowContainer, p, workflow.Name, workflow.Namespace)
 		}
+		defaultFlowContainer.Env = append(defaultFlowContainer.Env, security.ConfigureOIDCEnv(security.RetrieveOIDCConfiguration(workflow, plf))...)
+		kafkaPlatform, err := kafka.GetWorkflowKafkaPlatform(workflow, plf)
+		if err != nil {
+			return nil, err
+		}
+		defaultFlowContainer.Env = append(defaultFlowContainer.Env, kafka.ConfigureEnv(kafka.GetPlatformKafka(kafkaPlatform))...)
 	}
+	defaultFlowContainer.Env = append(defaultFlowContainer.Env, security.ConfigureFunctionAuthEnv(workflow)...)
 	// immutable
 	defaultFlowContainer.Name = operatorapi.DefaultContainerName
 	portIdx := -1
@@ -254,6 +324,11 @@ func defaultContainer(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataF
 func ServiceCreator(workflow *operatorapi.SonataFlow) (client.Object, error) {
 	lbl := workflowproj.GetMergedLabels(workflow)
 
+	selector := workflowproj.GetMergedLabels(workflow)
+	if color := getBlueGreenActiveColor(workflow); len(color) > 0 {
+		selector[workflowproj.LabelDeploymentColor] = string(color)
+	}
+
 	service := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      workflow.Name,
@@ -261,7 +336,7 @@ func ServiceCreator(workflow *operatorapi.SonataFlow) (client.Object, error) {
 			Labels:    lbl,
 		},
 		Spec: corev1.ServiceSpec{
-			Selector: lbl,
+			Selector: selector,
 			Ports: []corev1.ServicePort{{
 				Name:       k8sServicePortName,
 				Protocol:   corev1.ProtocolTCP,
@@ -292,6 +367,9 @@ func SinkBindingCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.Sonat
 	if workflow.Spec.PodTemplate.DeploymentModel == operatorapi.KnativeDeploymentModel {
 		apiVersion = knativeServingAPIVersion // use knative serving API Version
 		kind = knativeServiceKind
+	} else if workflow.IsJobDeployment() {
+		apiVersion = jobAPIVersion
+		kind = jobKind
 	}
 
 	// subject must be deployment to inject K_SINK, service won't work
@@ -315,10 +393,18 @@ func SinkBindingCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.Sonat
 			},
 		},
 	}
+	if workflow.IsBlueGreenDeployment() {
+		// binds both blue/green Deployments
+		sinkBinding.Spec.Subject.Name = ""
+		sinkBinding.Spec.Subject.Selector = &metav1.LabelSelector{MatchLabels: workflowproj.GetSelectorLabels(workflow)}
+	}
 	return sinkBinding, nil
 }
 
 func getBrokerRefFromPlatform(plf *operatorapi.SonataFlowPlatform) (*duckv1.KReference, error) {
+	if plf == nil {
+		return nil, nil
+	}
 	// check the local platform
 	if plf.Spec.Eventing != nil && plf.Spec.Eventing.Broker != nil && plf.Spec.Eventing.Broker.Ref != nil {
 		ref := plf.Spec.Eventing.Broker.Ref.DeepCopy()
@@ -391,7 +477,7 @@ func TriggersCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFl
 		// The trigger must be created in the same namespace as the broker
 		trigger := &eventingv1.Trigger{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      kmeta.ChildName(strings.ToLower(fmt.Sprintf("%s-%s-", workflow.Name, event.Name)), string(workflow.GetUID())),
+				Name:      triggerName(workflow, event.Name),
 				Namespace: brokerRef.Namespace,
 				Labels:    lbl,
 			},
@@ -417,6 +503,177 @@ func TriggersCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFl
 	return resultObjects, nil
 }
 
+// triggerName gets the name of the Trigger delivering the given consumed event to the workflow.
+func triggerName(workflow *operatorapi.SonataFlow, eventName string) string {
+	return kmeta.ChildName(strings.ToLower(fmt.Sprintf("%s-%s-", workflow.Name, eventName)), string(workflow.GetUID()))
+}
+
+// EventTypesCreator is an ObjectsCreator for EventTypes.
+// It will create a list of eventingv1beta2.EventType describing the events consumed and produced by the workflow in the broker
+// delivering them, so that they're listed in the Knative Eventing catalog. The events not going through a broker are skipped.
+func EventTypesCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) ([]client.Object, error) {
+	var resultObjects []client.Object
+	lbl := workflowproj.GetMergedLabels(workflow)
+	for _, event := range workflow.Spec.Flow.Events {
+		var brokerRef *duckv1.KReference
+		var err error
+		description := fmt.Sprintf("Consumed by the workflow %s/%s", workflow.Namespace, workflow.Name)
+		if event.Kind == cncfmodel.EventKindProduced {
+			description = fmt.Sprintf("Produced by the workflow %s/%s", workflow.Namespace, workflow.Name)
+			brokerRef, err = getSinkRefForEventType(event.Type, workflow, plf)
+		} else {
+			brokerRef, err = getBrokerRefForEventType(event.Type, workflow, plf)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if brokerRef == nil || !knative.IsKnativeBroker(brokerRef) {
+			continue
+		}
+		eventType, err := knative.NewEventType(eventTypeName(workflow, event.Name), lbl, brokerRef, event.Type, event.Source, eventDataSchema(event), description)
+		if err != nil {
+			return nil, err
+		}
+		resultObjects = append(resultObjects, eventType)
+	}
+	return resultObjects, nil
+}
+
+// getSinkRefForEventType gets the destination reference of the given produced event type, nil if it's not a reference.
+func getSinkRefForEventType(eventType string, workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) (*duckv1.KReference, error) {
+	var sink *duckv1.Destination
+	for i := range workflow.Spec.Sinks {
+		if workflow.Spec.Sinks[i].EventType == eventType {
+			sink = &workflow.Spec.Sinks[i].Destination
+			break
+		}
+	}
+	if sink == nil {
+		var err error
+		if sink, err = knative.GetWorkflowSink(workflow, plf); err != nil || sink == nil {
+			return nil, err
+		}
+	}
+	if sink.Ref == nil {
+		return nil, nil
+	}
+	ref := sink.Ref.DeepCopy()
+	if len(ref.Namespace) == 0 {
+		ref.Namespace = workflow.Namespace // default to the workflow namespace
+	}
+	return ref, nil
+}
+
+// eventDataSchema gets the data schema of the given event declared in its `dataschema` metadata, if any.
+func eventDataSchema(event cncfmodel.Event) string {
+	if schema, ok := event.Metadata[eventDataSchemaMetadata]; ok {
+		return schema.StringValue
+	}
+	return ""
+}
+
+// eventTypeName gets the name of the EventType describing the given workflow event, the same one of its Trigger.
+func eventTypeName(workflow *operatorapi.SonataFlow, eventName string) string {
+	return triggerName(workflow, eventName)
+}
+
+// ScaledObjectCreator is an ObjectCreatorWithPlatform for the KEDA ScaledObject scaling the workflow Deployment on the lag of its consumed events.
+// Events delivered by a Knative Kafka Broker are read from the broker topic with the trigger consumer group, otherwise the topic is the event type,
+// named after the platform Kafka eventing if any, whose bootstrap servers are used by default.
+// It returns nil if the workflow doesn't require event-driven autoscaling, if none of its events can be read from Kafka,
+// or if it's rolled out in blue/green colors and no color is active yet.
+func ScaledObjectCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) (client.Object, error) {
+	if !workflow.IsEventDrivenAutoscalingEnabled() {
+		return nil, nil
+	}
+	deploymentName := workflow.Name
+	if workflow.IsBlueGreenDeployment() {
+		color := getBlueGreenActiveColor(workflow)
+		if len(color) == 0 {
+			// the first color is still being rolled out, there's no Deployment to scale yet
+			return nil, nil
+		}
+		deploymentName = GetBlueGreenDeploymentName(workflow, color)
+	}
+	kafkaPlatform, err := kafka.GetWorkflowKafkaPlatform(workflow, plf)
+	if err != nil {
+		return nil, err
+	}
+	triggers, _, err := kafkaLagTriggers(workflow, plf, kafkaPlatform)
+	if err != nil || len(triggers) == 0 {
+		return nil, err
+	}
+	autoscaling := workflow.Spec.PodTemplate.EventDrivenAutoscaling.DeepCopy()
+	if len(autoscaling.Kafka.BootstrapServers) == 0 && kafkaPlatform != nil {
+		autoscaling.Kafka.BootstrapServers = kafka.GetPlatformKafka(kafkaPlatform).BootstrapServers
+	}
+	if len(autoscaling.Kafka.BootstrapServers) == 0 {
+		return nil, fmt.Errorf("no Kafka bootstrap servers to read the backlog of the workflow %s/%s, set them in the event-driven autoscaling or the platform Kafka eventing", workflow.Namespace, workflow.Name)
+	}
+	scaledObject := keda.NewScaledObject(workflow.Name, workflow.Namespace)
+	scaledObject.SetLabels(workflowproj.GetMergedLabels(workflow))
+	if err := keda.SetScaledObjectSpec(scaledObject, deploymentName, autoscaling, triggers); err != nil {
+		return nil, err
+	}
+	return scaledObject, nil
+}
+
+// GetUnscalableEvents returns the names of the workflow consumed events delivered by a Knative Broker other than the
+// Knative Kafka Broker, whose backlog can't be read by the KEDA Kafka scaler.
+func GetUnscalableEvents(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) ([]string, error) {
+	_, unscalable, err := kafkaLagTriggers(workflow, plf, nil)
+	return unscalable, err
+}
+
+// kafkaLagTriggers returns the Kafka topics and consumer groups of the workflow consumed events, along with the names of
+// the events delivered by a non-Kafka Knative Broker, which are skipped.
+func kafkaLagTriggers(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform, kafkaPlatform *operatorapi.SonataFlowPlatform) ([]keda.KafkaTrigger, []string, error) {
+	var triggers []keda.KafkaTrigger
+	var unscalable []string
+	for _, event := range workflow.Spec.Flow.Events {
+		if event.Kind == cncfmodel.EventKindProduced {
+			continue
+		}
+		brokerRef, err := getBrokerRefForEventType(event.Type, workflow, plf)
+		if err != nil {
+			return nil, nil, err
+		}
+		if brokerRef != nil && knative.IsKnativeBroker(brokerRef) {
+			isKafka, err := knative.IsKafkaBroker(brokerRef)
+			if err != nil {
+				return nil, nil, err
+			}
+			if !isKafka {
+				unscalable = append(unscalable, event.Name)
+				continue
+			}
+			// the trigger lives in the broker namespace, see TriggersCreator
+			triggers = append(triggers, keda.KnativeKafkaBrokerTrigger(brokerRef.Namespace, brokerRef.Name, brokerRef.Namespace, triggerName(workflow, event.Name)))
+		} else {
+			topic := event.Type
+			if kafkaPlatform != nil {
+				topic = kafka.TopicName(kafkaPlatform, event.Type)
+			}
+			triggers = append(triggers, keda.KafkaTrigger{Topic: topic, ConsumerGroup: kafka.ConsumerGroup(workflow)})
+		}
+	}
+	return triggers, unscalable, nil
+}
+
+// CertificateCreator is an ObjectCreatorWithPlatform for the cert-manager Certificate of the workflow Service.
+// Returns nil if the workflow isn't served over HTTPS.
+func CertificateCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) (client.Object, error) {
+	if !certmanager.UsesTLS(workflow, plf) {
+		return nil, nil
+	}
+	certificate := certmanager.NewCertificate(workflow.Name, workflow.Namespace)
+	certificate.SetLabels(workflowproj.GetMergedLabels(workflow))
+	if err := certmanager.SetCertificateSpec(certificate, plf.Spec.TLS, workflow.Name, workflow.Namespace); err != nil {
+		return nil, err
+	}
+	return certificate, nil
+}
+
 // OpenShiftRouteCreator is an ObjectCreator for a basic Route for a workflow running on OpenShift.
 // It enables the exposition of the service using an OpenShift Route.
 // See: https://github.com/openshift/api/blob/d170fcdc0fa638b664e4f35f2daf753cb4afe36b/route/v1/route.crd.yaml
@@ -425,6 +682,205 @@ func OpenShiftRouteCreator(workflow *operatorapi.SonataFlow) (client.Object, err
 	return route, err
 }
 
+// IngressCreator is an ObjectCreator for the Ingress exposing the workflow service as defined in the workflow exposure.
+// Returns nil if the workflow isn't exposed, is deployed with Knative Serving, which handles its own routes, or is run as a Job.
+func IngressCreator(workflow *operatorapi.SonataFlow) (client.Object, error) {
+	if workflow.Spec.Exposure == nil || !workflow.IsKubernetesDeployment() {
+		return nil, nil
+	}
+	meta := metav1.ObjectMeta{
+		Name:      workflow.Name,
+		Namespace: workflow.Namespace,
+		Labels:    workflowproj.GetMergedLabels(workflow),
+	}
+	return kubeutil.IngressForService(meta, workflow.Spec.Exposure, workflow.Name, defaultHTTPServicePort), nil
+}
+
+// HTTPRouteCreator is an ObjectsCreator for a Gateway API HTTPRoute routing the traffic to the workflow Service.
+// It returns nil if the workflow doesn't define a route or it's deployed as a Knative Service or a Job.
+func HTTPRouteCreator(workflow *operatorapi.SonataFlow) (client.Object, error) {
+	if workflow.Spec.HTTPRoute == nil || !workflow.IsKubernetesDeployment() {
+		return nil, nil
+	}
+	route := &gwapi.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workflow.Name,
+			Namespace: workflow.Namespace,
+			Labels:    workflowproj.GetMergedLabels(workflow),
+		},
+	}
+	gateway.SetHTTPRouteSpec(route, workflow.Spec.HTTPRoute, workflow.Name, defaultHTTPServicePort)
+	return route, nil
+}
+
+// PodDisruptionBudgetCreator is an ObjectsCreator for the PodDisruptionBudget protecting the workflow pods.
+// It returns nil if the workflow runs a single replica, it's deployed as a Knative Service or the budget is disabled.
+func PodDisruptionBudgetCreator(workflow *operatorapi.SonataFlow) (client.Object, error) {
+	if !workflow.IsDisruptionBudgetRequired() {
+		return nil, nil
+	}
+	budget := workflow.Spec.PodTemplate.DisruptionBudget
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workflow.Name,
+			Namespace: workflow.Namespace,
+			Labels:    workflowproj.GetMergedLabels(workflow),
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable:   budget.GetMinAvailable(),
+			MaxUnavailable: budget.GetMaxUnavailable(),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: workflowproj.GetSelectorLabels(workflow),
+			},
+		},
+	}
+	return pdb, nil
+}
+
+// CronJobCreator is an ObjectCreatorWithPlatform for the CronJob starting the workflow on the cron schedule defined in the flow start.
+// Every Job posts an empty input to the workflow endpoint, creating a new workflow instance.
+// Returns nil if the flow doesn't define a cron start or the workflow is run as a Job. The CronJob is suspended once the cron validUntil is reached.
+func CronJobCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) (client.Object, error) {
+	startCron := workflow.GetStartCron()
+	if startCron == nil || workflow.IsJobDeployment() {
+		return nil, nil
+	}
+	schedule := workflow.Spec.Flow.Start.Schedule
+	next, err := nextScheduleTime(schedule, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	// the trigger pods must not match the workflow Service selector
+	podLabels := map[string]string{
+		workflowproj.LabelK8SName:      workflow.Name,
+		workflowproj.LabelK8SComponent: cronTriggerComponent,
+		workflowproj.LabelK8SManagedBy: "sonataflow-operator",
+	}
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workflow.Name,
+			Namespace: workflow.Namespace,
+			Labels:    workflowproj.GetMergedLabels(workflow),
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule:          startCron.Expression,
+			Suspend:           utils.Pbool(next == nil),
+			ConcurrencyPolicy: batchv1.AllowConcurrent,
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					BackoffLimit: utils.Pint(cronTriggerBackoffLimit),
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: podLabels},
+						Spec: corev1.PodSpec{
+							RestartPolicy: corev1.RestartPolicyNever,
+							Containers: []corev1.Container{{
+								Name:            cronTriggerContainerName,
+								Image:           cfg.GetCfg().WorkflowTriggerImageTag,
+								Command:         []string{"/bin/sh", "-c", cronTriggerScript},
+								Env:             cronTriggerEnv(workflow, plf),
+								SecurityContext: kubeutil.SecurityDefaults(),
+							}},
+						},
+					},
+				},
+			},
+		},
+	}
+	if len(schedule.Timezone) > 0 {
+		cronJob.Spec.TimeZone = &schedule.Timezone
+	}
+	return cronJob, nil
+}
+
+// cronTriggerEnv returns the in-cluster endpoint of the workflow posted by the trigger, and the credentials of the
+// workflow OIDC client if its endpoints are secured with OIDC.
+func cronTriggerEnv(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) []corev1.EnvVar {
+	env := []corev1.EnvVar{{
+		Name:  "WORKFLOW_URL",
+		Value: fmt.Sprintf("%s://%s.%s/%s", constants.DefaultHTTPProtocol, workflow.Name, workflow.Namespace, workflow.Name),
+	}}
+	if !security.UsesOIDC(workflow, plf) {
+		return env
+	}
+	oidc := security.RetrieveOIDCConfiguration(workflow, plf)
+	env = append(env,
+		corev1.EnvVar{Name: "OIDC_AUTH_SERVER_URL", Value: oidc.AuthServerURL},
+		corev1.EnvVar{Name: "OIDC_CLIENT_ID", Value: oidc.ClientID})
+	return append(env, security.ConfigureOIDCEnv(oidc)...)
+}
+
+// NetworkPolicyCreator is an ObjectsCreator for the NetworkPolicy allowing only the traffic required by the workflow.
+// It returns nil if the platform doesn't generate NetworkPolicies.
+// The services discovered by the workflow are allowed by the NetworkPolicyMutateVisitor once the managed properties are resolved.
+func NetworkPolicyCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) (client.Object, error) {
+	if !plf.IsNetworkPoliciesEnabled() {
+		return nil, nil
+	}
+	meta := metav1.ObjectMeta{
+		Name:      workflow.Name,
+		Namespace: workflow.Namespace,
+		Labels:    workflowproj.GetMergedLabels(workflow),
+	}
+	traffic, err := workflowNetworkPolicyTraffic(workflow, plf, "")
+	if err != nil {
+		return nil, err
+	}
+	return kubeutil.NetworkPolicyForTraffic(meta, traffic), nil
+}
+
+// workflowNetworkPolicyTraffic computes the traffic of the given workflow:
+// the platform services and the Knative Eventing data plane calling it, the services discovered in its managed properties,
+// its persistence, the Kafka brokers it produces and consumes events from, and the external networks it calls.
+func workflowNetworkPolicyTraffic(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform, managedProperties string) (*kubeutil.NetworkPolicyTraffic, error) {
+	spec := plf.Spec.NetworkPolicies
+	traffic := &kubeutil.NetworkPolicyTraffic{
+		PodSelector:        workflowproj.GetSelectorLabels(workflow),
+		HTTPPort:           variables.DefaultHTTPWorkflowPortIntStr,
+		HTTPFromAnywhere:   workflow.Spec.Exposure != nil || workflow.Spec.HTTPRoute != nil,
+		HTTPFromNamespaces: spec.IngressNamespaces,
+		// the Knative Serving data plane routes the traffic to the workflow pods from its own components
+		FromAnywhere: workflow.IsKnativeDeployment(),
+		ToNamespaces: discoveredNamespaces(managedProperties),
+		ToCIDRs:      spec.EgressCIDRs,
+	}
+	if workflowdef.ContainsEventKind(workflow, cncfmodel.EventKindConsumed) {
+		traffic.FromNamespaces = append(traffic.FromNamespaces, knative.EventingNamespace)
+	}
+	if workflowdef.ContainsEventKind(workflow, cncfmodel.EventKindProduced) || workflow.Spec.Sink != nil {
+		traffic.ToNamespaces = append(traffic.ToNamespaces, knative.EventingNamespace)
+	}
+	if certmanager.UsesTLS(workflow, plf) {
+		httpsPort := intstr.FromInt32(certmanager.HTTPSPort)
+		traffic.HTTPSPort = &httpsPort
+	}
+	if !profiles.IsDevProfile(workflow) {
+		if p := persistence.RetrieveConfiguration(workflow.Spec.Persistence, plf.Spec.Persistence, workflow.Name); p != nil {
+			traffic.ToNamespaces = append(traffic.ToNamespaces, persistence.PostgreSQLServiceNamespace(p.PostgreSQL, workflow.Namespace))
+		}
+	}
+	kafkaPlatform, err := kafka.GetWorkflowKafkaPlatform(workflow, plf)
+	if err != nil {
+		return nil, err
+	}
+	traffic.ToServers = kafka.GetBootstrapServers(kafka.GetPlatformKafka(kafkaPlatform))
+	return traffic, nil
+}
+
+// discoveredNamespaces returns the namespaces of the in-cluster addresses resolved in the given managed properties.
+func discoveredNamespaces(managedProperties string) []string {
+	props, err := magicproperties.LoadString(managedProperties)
+	if err != nil {
+		return nil
+	}
+	var namespaces []string
+	for _, value := range props.Map() {
+		if namespace, ok := kubeutil.ClusterNamespaceFromURL(value); ok {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return namespaces
+}
+
 // UserPropsConfigMapCreator creates an empty ConfigMap to hold the user application properties
 func UserPropsConfigMapCreator(workflow *operatorapi.SonataFlow) (client.Object, error) {
 	return workflowproj.CreateNewUserPropsConfigMap(workflow), nil
@@ -26,16 +26,25 @@ import (
 	"github.com/apache/incubator-kie-kogito-serverless-operator/api/metadata"
 	"github.com/magiconair/properties"
 	prometheus "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	cncfmodel "github.com/serverlessworkflow/sdk-go/v2/model"
 	"github.com/stretchr/testify/assert"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"knative.dev/eventing/pkg/apis/eventing"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	eventingv1beta2 "knative.dev/eventing/pkg/apis/eventing/v1beta2"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 	"knative.dev/pkg/kmeta"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/certmanager"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/cfg"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/knative"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
 	kubeutil "github.com/apache/incubator-kie-kogito-serverless-operator/utils/kubernetes"
 
@@ -375,6 +384,64 @@ func TestEnsureWorkflowTriggersWithoutBrokerAreNotCreated(t *testing.T) {
 	assert.Nil(t, triggers)
 }
 
+func TestEventTypesCreatorWithPlatformBroker(t *testing.T) {
+	workflow := test.GetVetEventSonataFlow(t.Name())
+	workflow.Spec.Sink = nil
+	workflow.Spec.Sources = nil
+	workflow.Spec.Flow.Events[0].Metadata = cncfmodel.Metadata{"dataschema": cncfmodel.FromString("https://vet.example.com/schemas/appointment.json")}
+	plf := test.GetBasePlatformWithBroker()
+	plf.Namespace = "platform-namespace"
+	plf.Spec.Eventing.Broker.Ref.Namespace = plf.Namespace
+	broker := test.GetDefaultBroker(plf.Namespace)
+
+	cl := test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(workflow, broker).WithStatusSubresource(workflow, broker).Build()
+	utils.SetClient(cl)
+
+	eventTypes, err := EventTypesCreator(workflow, plf)
+	assert.NoError(t, err)
+	assert.Len(t, eventTypes, 3)
+
+	produced := getEventType(kmeta.ChildName("vet-makevetappointment-", string(workflow.GetUID())), eventTypes)
+	assert.NotNil(t, produced)
+	assert.Equal(t, plf.Namespace, produced.Namespace) //event type should be in the broker namespace
+	assert.Equal(t, "events.vet.appointments", produced.Spec.Type)
+	assert.Equal(t, "VetServiceSource", produced.Spec.Source.String())
+	assert.Equal(t, "https://vet.example.com/schemas/appointment.json", produced.Spec.Schema.String())
+	assert.Equal(t, "Produced by the workflow "+workflow.Namespace+"/vet", produced.Spec.Description)
+	assert.Equal(t, "Broker", produced.Spec.Reference.Kind)
+	assert.Equal(t, "default", produced.Spec.Reference.Name)
+	assert.Equal(t, workflowproj.GetMergedLabels(workflow), produced.GetLabels())
+
+	consumed := getEventType(kmeta.ChildName("vet-vetappointmentrequestreceived-", string(workflow.GetUID())), eventTypes)
+	assert.NotNil(t, consumed)
+	assert.Equal(t, plf.Namespace, consumed.Namespace)
+	assert.Equal(t, "events.vet.appointments.request", consumed.Spec.Type)
+	assert.Equal(t, "checkAccountInfo", consumed.Spec.Source.String())
+	assert.Nil(t, consumed.Spec.Schema)
+	assert.Equal(t, "Consumed by the workflow "+workflow.Namespace+"/vet", consumed.Spec.Description)
+	assert.Equal(t, "default", consumed.Spec.Reference.Name)
+}
+
+func TestEventTypesCreatorWithoutBroker(t *testing.T) {
+	workflow := test.GetVetEventSonataFlow(t.Name())
+	workflow.Spec.Sink = nil
+	workflow.Spec.Sources = nil
+	plf := test.GetBasePlatform()
+
+	eventTypes, err := EventTypesCreator(workflow, plf)
+	assert.NoError(t, err)
+	assert.Empty(t, eventTypes)
+}
+
+func getEventType(name string, eventTypes []client.Object) *eventingv1beta2.EventType {
+	for _, eventType := range eventTypes {
+		if eventType.GetName() == name {
+			return eventType.(*eventingv1beta2.EventType)
+		}
+	}
+	return nil
+}
+
 func TestMergePodSpec_WithPostgreSQL_and_JDBC_URL_field(t *testing.T) {
 	workflow := test.GetBaseSonataFlow(t.Name())
 	workflow.Spec = v1alpha08.SonataFlowSpec{
@@ -824,7 +891,12 @@ func doTestDefaultContainer_WithPlatformPersistence(t *testing.T, workflow *v1al
 	assert.Equal(t, corev1.Protocol("TCP"), container.Ports[0].Protocol)
 	assert.Equal(t, "", container.Ports[0].HostIP)
 
-	//verify default container health checks
+	//verify default container health checks, the dev profile raises the failure thresholds
+	var probeFailureThreshold, startupFailureThreshold int32 = 0, healthStartedFailureThreshold
+	if workflowproj.IsDevProfile(workflow) {
+		probeFailureThreshold = cfg.GetCfg().HealthFailureThresholdDevMode
+		startupFailureThreshold = cfg.GetCfg().HealthFailureThresholdDevMode
+	}
 	assert.Equal(t, &corev1.Probe{
 		ProbeHandler: corev1.ProbeHandler{
 			Exec: nil,
@@ -846,7 +918,7 @@ func doTestDefaultContainer_WithPlatformPersistence(t *testing.T, workflow *v1al
 		TimeoutSeconds:                3,
 		PeriodSeconds:                 15,
 		SuccessThreshold:              0,
-		FailureThreshold:              0,
+		FailureThreshold:              probeFailureThreshold,
 		TerminationGracePeriodSeconds: nil,
 	}, container.LivenessProbe)
 
@@ -871,7 +943,7 @@ func doTestDefaultContainer_WithPlatformPersistence(t *testing.T, workflow *v1al
 		TimeoutSeconds:                3,
 		PeriodSeconds:                 15,
 		SuccessThreshold:              0,
-		FailureThreshold:              0,
+		FailureThreshold:              probeFailureThreshold,
 		TerminationGracePeriodSeconds: nil,
 	}, container.ReadinessProbe)
 
@@ -896,7 +968,7 @@ func doTestDefaultContainer_WithPlatformPersistence(t *testing.T, workflow *v1al
 		TimeoutSeconds:                3,
 		PeriodSeconds:                 15,
 		SuccessThreshold:              0,
-		FailureThreshold:              5,
+		FailureThreshold:              startupFailureThreshold,
 		TerminationGracePeriodSeconds: nil,
 	}, container.StartupProbe)
 
@@ -967,3 +1039,332 @@ func TestEnsureWorkflowServiceMonitorIsCreatedWhenDeployedAsDeployment(t *testin
 		"app.kubernetes.io/component":       "serverless-workflow",
 		"app.kubernetes.io/managed-by":      "sonataflow-operator"})
 }
+
+func TestDeploymentCreator_DefaultTopologySpreadWithReplicas(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Spec.PodTemplate.Replicas = utils.Pint(3)
+
+	object, err := DeploymentCreator(workflow, nil)
+	assert.NoError(t, err)
+	constraints := object.(*appsv1.Deployment).Spec.Template.Spec.TopologySpreadConstraints
+	assert.Len(t, constraints, 2)
+	assert.Equal(t, corev1.LabelTopologyZone, constraints[0].TopologyKey)
+	assert.Equal(t, corev1.LabelHostname, constraints[1].TopologyKey)
+	assert.Equal(t, int32(1), constraints[0].MaxSkew)
+	assert.Equal(t, corev1.ScheduleAnyway, constraints[0].WhenUnsatisfiable)
+	assert.Equal(t, workflowproj.GetSelectorLabels(workflow), constraints[0].LabelSelector.MatchLabels)
+
+	// user defined constraints win
+	workflow.Spec.PodTemplate.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{{TopologyKey: "rack", MaxSkew: 2}}
+	object, err = DeploymentCreator(workflow, nil)
+	assert.NoError(t, err)
+	constraints = object.(*appsv1.Deployment).Spec.Template.Spec.TopologySpreadConstraints
+	assert.Len(t, constraints, 1)
+	assert.Equal(t, "rack", constraints[0].TopologyKey)
+
+	// skipped when disabled
+	workflow.Spec.PodTemplate.TopologySpreadConstraints = nil
+	workflow.Spec.PodTemplate.TopologySpread = &v1alpha08.TopologySpreadSpec{Disabled: true}
+	object, err = DeploymentCreator(workflow, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, object.(*appsv1.Deployment).Spec.Template.Spec.TopologySpreadConstraints)
+}
+
+func TestDeploymentCreator_NoTopologySpreadWithSingleReplica(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+
+	object, err := DeploymentCreator(workflow, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, object.(*appsv1.Deployment).Spec.Template.Spec.TopologySpreadConstraints)
+}
+
+func TestDeploymentMutateVisitor_DisableTopologySpread(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Spec.PodTemplate.Replicas = utils.Pint(3)
+	object, err := DeploymentCreator(workflow, nil)
+	assert.NoError(t, err)
+	deployment := object.(*appsv1.Deployment)
+	deployment.ResourceVersion = "1"
+	assert.Len(t, deployment.Spec.Template.Spec.TopologySpreadConstraints, 2)
+
+	workflow.Spec.PodTemplate.TopologySpread = &v1alpha08.TopologySpreadSpec{Disabled: true}
+	assert.NoError(t, DeploymentMutateVisitor(workflow, nil)(deployment)())
+	assert.Empty(t, deployment.Spec.Template.Spec.TopologySpreadConstraints)
+}
+
+func TestPodDisruptionBudgetCreator(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+
+	object, err := PodDisruptionBudgetCreator(workflow)
+	assert.NoError(t, err)
+	assert.Nil(t, object)
+
+	workflow.Spec.PodTemplate.Replicas = utils.Pint(3)
+	object, err = PodDisruptionBudgetCreator(workflow)
+	assert.NoError(t, err)
+	pdb := object.(*policyv1.PodDisruptionBudget)
+	assert.Nil(t, pdb.Spec.MinAvailable)
+	assert.Equal(t, intstr.FromInt32(1), *pdb.Spec.MaxUnavailable)
+	assert.Equal(t, workflowproj.GetSelectorLabels(workflow), pdb.Spec.Selector.MatchLabels)
+
+	minAvailable := intstr.FromString("50%")
+	workflow.Spec.PodTemplate.DisruptionBudget = &v1alpha08.PodDisruptionBudgetSpec{MinAvailable: &minAvailable}
+	object, err = PodDisruptionBudgetCreator(workflow)
+	assert.NoError(t, err)
+	pdb = object.(*policyv1.PodDisruptionBudget)
+	assert.Equal(t, minAvailable, *pdb.Spec.MinAvailable)
+	assert.Nil(t, pdb.Spec.MaxUnavailable)
+
+	workflow.Spec.PodTemplate.DisruptionBudget.Disabled = true
+	object, err = PodDisruptionBudgetCreator(workflow)
+	assert.NoError(t, err)
+	assert.Nil(t, object)
+}
+
+func TestScaledObjectCreatorWithPlatformBroker(t *testing.T) {
+	workflow := test.GetVetEventSonataFlow(t.Name())
+	workflow.Spec.Sink = nil
+	workflow.Spec.Sources = nil
+	workflow.Spec.PodTemplate.EventDrivenAutoscaling = &v1alpha08.EventDrivenAutoscalingSpec{
+		MaxReplicas: utils.Pint(5),
+		Kafka:       v1alpha08.KafkaLagScalerSpec{BootstrapServers: "my-cluster-kafka-bootstrap.kafka:9092", AuthenticationRef: "kafka-auth"},
+	}
+	plf := test.GetBasePlatformWithBroker()
+	plf.Namespace = "platform-namespace"
+	plf.Spec.Eventing.Broker.Ref.Namespace = plf.Namespace
+	broker := test.GetDefaultBroker(plf.Namespace)
+	broker.Annotations = map[string]string{eventing.BrokerClassKey: knative.KafkaBrokerClass}
+	utils.SetClient(test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(broker).Build())
+
+	object, err := ScaledObjectCreator(workflow, plf)
+	assert.NoError(t, err)
+	scaledObject := object.(*unstructured.Unstructured)
+	assert.Equal(t, "ScaledObject", scaledObject.GetKind())
+	target, _, _ := unstructured.NestedString(scaledObject.Object, "spec", "scaleTargetRef", "name")
+	assert.Equal(t, workflow.Name, target)
+	minReplicas, _, _ := unstructured.NestedInt64(scaledObject.Object, "spec", "minReplicaCount")
+	assert.Equal(t, int64(1), minReplicas)
+	maxReplicas, _, _ := unstructured.NestedInt64(scaledObject.Object, "spec", "maxReplicaCount")
+	assert.Equal(t, int64(5), maxReplicas)
+	triggers, _, _ := unstructured.NestedSlice(scaledObject.Object, "spec", "triggers")
+	assert.Len(t, triggers, 2)
+	trigger := triggers[0].(map[string]interface{})
+	assert.Equal(t, "kafka", trigger["type"])
+	assert.Equal(t, "kafka-auth", trigger["authenticationRef"].(map[string]interface{})["name"])
+	metadata := trigger["metadata"].(map[string]interface{})
+	assert.Equal(t, "my-cluster-kafka-bootstrap.kafka:9092", metadata["bootstrapServers"])
+	assert.Equal(t, "knative-broker-platform-namespace-default", metadata["topic"])
+	assert.Equal(t, "knative-trigger-platform-namespace-"+kmeta.ChildName("vet-vetappointmentinfo-", string(workflow.GetUID())), metadata["consumerGroup"])
+	assert.Equal(t, "10", metadata["lagThreshold"])
+	unscalable, err := GetUnscalableEvents(workflow, plf)
+	assert.NoError(t, err)
+	assert.Empty(t, unscalable)
+
+	// the backlog of a broker not backed by Kafka can't be read
+	broker.Annotations[eventing.BrokerClassKey] = "MTChannelBasedBroker"
+	utils.SetClient(test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(broker).Build())
+	object, err = ScaledObjectCreator(workflow, plf)
+	assert.NoError(t, err)
+	assert.Nil(t, object)
+	unscalable, err = GetUnscalableEvents(workflow, plf)
+	assert.NoError(t, err)
+	assert.Len(t, unscalable, 2)
+}
+
+func TestScaledObjectCreatorWithoutBroker(t *testing.T) {
+	workflow := test.GetVetEventSonataFlow(t.Name())
+	workflow.Spec.Sink = nil
+	workflow.Spec.Sources = nil
+	workflow.Spec.PodTemplate.EventDrivenAutoscaling = &v1alpha08.EventDrivenAutoscalingSpec{
+		Kafka: v1alpha08.KafkaLagScalerSpec{BootstrapServers: "kafka:9092"},
+	}
+
+	object, err := ScaledObjectCreator(workflow, nil)
+	assert.NoError(t, err)
+	triggers, _, _ := unstructured.NestedSlice(object.(*unstructured.Unstructured).Object, "spec", "triggers")
+	assert.Len(t, triggers, 2)
+	metadata := triggers[0].(map[string]interface{})["metadata"].(map[string]interface{})
+	assert.Equal(t, "events.vet.appointments", metadata["topic"])
+	assert.Equal(t, workflow.Namespace+"."+workflow.Name, metadata["consumerGroup"])
+
+	// the events are consumed unless stated otherwise
+	for i := range workflow.Spec.Flow.Events {
+		workflow.Spec.Flow.Events[i].Kind = ""
+	}
+	object, err = ScaledObjectCreator(workflow, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, object)
+
+	// no ScaledObject without autoscaling
+	workflow.Spec.PodTemplate.EventDrivenAutoscaling = nil
+	object, err = ScaledObjectCreator(workflow, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, object)
+}
+
+func TestScaledObjectCreatorWithPlatformKafka(t *testing.T) {
+	workflow := test.GetVetEventSonataFlow(t.Name())
+	workflow.Spec.Sink = nil
+	workflow.Spec.Sources = nil
+	workflow.Spec.PodTemplate.EventDrivenAutoscaling = &v1alpha08.EventDrivenAutoscalingSpec{}
+	plf := test.GetBasePlatform()
+	plf.Namespace = "platform-namespace"
+	plf.Spec.Eventing = &v1alpha08.PlatformEventingSpec{Kafka: &v1alpha08.PlatformKafkaEventingSpec{
+		BootstrapServers: "my-cluster-kafka-bootstrap.kafka:9092",
+		TopicNaming:      v1alpha08.NamespacePrefixedKafkaTopicNaming,
+	}}
+
+	object, err := ScaledObjectCreator(workflow, plf)
+	assert.NoError(t, err)
+	triggers, _, _ := unstructured.NestedSlice(object.(*unstructured.Unstructured).Object, "spec", "triggers")
+	assert.Len(t, triggers, 2)
+	metadata := triggers[0].(map[string]interface{})["metadata"].(map[string]interface{})
+	assert.Equal(t, "platform-namespace.events.vet.appointments", metadata["topic"])
+	assert.Equal(t, workflow.Namespace+"."+workflow.Name, metadata["consumerGroup"])
+	assert.Equal(t, "my-cluster-kafka-bootstrap.kafka:9092", metadata["bootstrapServers"])
+
+	// the workflow bootstrap servers take precedence
+	workflow.Spec.PodTemplate.EventDrivenAutoscaling.Kafka.BootstrapServers = "kafka:9092"
+	object, err = ScaledObjectCreator(workflow, plf)
+	assert.NoError(t, err)
+	triggers, _, _ = unstructured.NestedSlice(object.(*unstructured.Unstructured).Object, "spec", "triggers")
+	assert.Equal(t, "kafka:9092", triggers[0].(map[string]interface{})["metadata"].(map[string]interface{})["bootstrapServers"])
+
+	// no bootstrap servers at all
+	workflow.Spec.PodTemplate.EventDrivenAutoscaling.Kafka.BootstrapServers = ""
+	plf.Spec.Eventing = nil
+	_, err = ScaledObjectCreator(workflow, plf)
+	assert.Error(t, err)
+}
+
+func TestScaledObjectCreatorWithBlueGreen(t *testing.T) {
+	workflow := test.GetVetEventSonataFlow(t.Name())
+	workflow.Spec.Sink = nil
+	workflow.Spec.Sources = nil
+	workflow.Spec.PodTemplate.BlueGreen = &v1alpha08.BlueGreenRolloutSpec{}
+	workflow.Spec.PodTemplate.EventDrivenAutoscaling = &v1alpha08.EventDrivenAutoscalingSpec{
+		Kafka: v1alpha08.KafkaLagScalerSpec{BootstrapServers: "kafka:9092"},
+	}
+
+	// no active color yet
+	workflow.Status.BlueGreen = &v1alpha08.BlueGreenStatus{PreviewColor: v1alpha08.BlueDeploymentColor}
+	object, err := ScaledObjectCreator(workflow, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, object)
+
+	workflow.Status.BlueGreen = &v1alpha08.BlueGreenStatus{ActiveColor: v1alpha08.BlueDeploymentColor}
+	object, err = ScaledObjectCreator(workflow, nil)
+	assert.NoError(t, err)
+	name, _, _ := unstructured.NestedString(object.(*unstructured.Unstructured).Object, "spec", "scaleTargetRef", "name")
+	assert.Equal(t, GetBlueGreenDeploymentName(workflow, v1alpha08.BlueDeploymentColor), name)
+}
+
+func TestNetworkPolicyCreatorAndMutateVisitor(t *testing.T) {
+	workflow := test.GetVetEventSonataFlow(t.Name())
+	workflow.Spec.Persistence = &v1alpha08.PersistenceOptionsSpec{
+		PostgreSQL: &v1alpha08.PersistencePostgreSQL{
+			SecretRef: v1alpha08.PostgreSQLSecretOptions{Name: "test"},
+			ServiceRef: &v1alpha08.PostgreSQLServiceOptions{
+				SQLServiceOptions: &v1alpha08.SQLServiceOptions{Name: "postgres", Namespace: "db"},
+			},
+		},
+	}
+	plf := test.GetBasePlatform()
+
+	// no NetworkPolicy unless the platform generates them
+	object, err := NetworkPolicyCreator(workflow, plf)
+	assert.NoError(t, err)
+	assert.Nil(t, object)
+
+	plf.Spec.NetworkPolicies = &v1alpha08.PlatformNetworkPoliciesSpec{Enabled: true, EgressCIDRs: []string{"192.168.0.0/16"}}
+	object, err = NetworkPolicyCreator(workflow, plf)
+	assert.NoError(t, err)
+	policy := object.(*networkingv1.NetworkPolicy)
+	assert.Equal(t, workflowproj.GetSelectorLabels(workflow), policy.Spec.PodSelector.MatchLabels)
+	// the workflow consumes events from the Knative Eventing data plane and isn't exposed
+	assert.Len(t, policy.Spec.Ingress, 1)
+	assert.Equal(t, []string{knative.EventingNamespace}, policy.Spec.Ingress[0].From[1].NamespaceSelector.MatchExpressions[0].Values)
+	assert.Equal(t, []string{"db", knative.EventingNamespace}, policy.Spec.Egress[1].To[1].NamespaceSelector.MatchExpressions[0].Values)
+	assert.Equal(t, "192.168.0.0/16", policy.Spec.Egress[1].To[2].IPBlock.CIDR)
+
+	// the services discovered by the workflow are allowed once resolved in the managed properties
+	managedPropsCM := &corev1.ConfigMap{Data: map[string]string{
+		workflowproj.GetManagedPropertiesFileName(workflow): "quarkus.rest-client.vets.url=http://vets.services.svc:8080\n" +
+			"quarkus.rest-client.owners.url=https://owners.example.com\n",
+	}}
+	assert.NoError(t, NetworkPolicyMutateVisitor(workflow, plf, managedPropsCM)(policy)())
+	assert.Equal(t, []string{"db", knative.EventingNamespace, "services"}, policy.Spec.Egress[1].To[1].NamespaceSelector.MatchExpressions[0].Values)
+
+	// the exposed workflow accepts traffic on its HTTP port
+	workflow.Spec.Exposure = &v1alpha08.ExposureSpec{Host: "vet.example.com"}
+	assert.NoError(t, NetworkPolicyMutateVisitor(workflow, plf, managedPropsCM)(policy)())
+	assert.Len(t, policy.Spec.Ingress, 2)
+	assert.Empty(t, policy.Spec.Ingress[1].From)
+
+	// the platform Kafka brokers are allowed on their port when the workflow has no Knative sink
+	test.SetPreviewProfile(workflow)
+	workflow.Spec.Sink = nil
+	plf.Spec.Eventing = &v1alpha08.PlatformEventingSpec{Kafka: &v1alpha08.PlatformKafkaEventingSpec{BootstrapServers: "my-cluster-kafka-bootstrap.kafka.svc:9092, 10.0.0.1:9093"}}
+	assert.NoError(t, NetworkPolicyMutateVisitor(workflow, plf, managedPropsCM)(policy)())
+	assert.Len(t, policy.Spec.Egress, 4)
+	assert.Equal(t, []string{"kafka"}, policy.Spec.Egress[2].To[0].NamespaceSelector.MatchExpressions[0].Values)
+	assert.Equal(t, int32(9092), policy.Spec.Egress[2].Ports[0].Port.IntVal)
+	assert.Equal(t, "10.0.0.1/32", policy.Spec.Egress[3].To[0].IPBlock.CIDR)
+	assert.Equal(t, int32(9093), policy.Spec.Egress[3].Ports[0].Port.IntVal)
+}
+
+func TestCertificateCreatorAndTLSDeployment(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	plf := test.GetBasePlatform()
+	object, err := CertificateCreator(workflow, plf)
+	assert.NoError(t, err)
+	assert.Nil(t, object)
+
+	// without cert-manager the certificate Secret would never be issued
+	plf.Spec.TLS = &v1alpha08.PlatformTLSSpec{IssuerRef: v1alpha08.CertManagerIssuerRef{Name: "sonataflow-ca", Kind: "ClusterIssuer"}}
+	object, err = DeploymentCreator(workflow, plf)
+	assert.NoError(t, err)
+	for _, volume := range object.(*appsv1.Deployment).Spec.Template.Spec.Volumes {
+		assert.NotEqual(t, "tls", volume.Name)
+	}
+
+	utils.SetDiscoveryClient(test.CreateFakeCertManagerDiscoveryClient())
+	assert.NoError(t, certmanager.SetAvailable(nil))
+	defer func() {
+		utils.SetDiscoveryClient(test.CreateFakeKnativeAndMonitoringDiscoveryClient())
+		assert.NoError(t, certmanager.SetAvailable(nil))
+	}()
+	object, err = CertificateCreator(workflow, plf)
+	assert.NoError(t, err)
+	certificate := object.(*unstructured.Unstructured)
+	assert.Equal(t, "Certificate", certificate.GetKind())
+	secretName, _, _ := unstructured.NestedString(certificate.Object, "spec", "secretName")
+	assert.Equal(t, workflow.Name+"-tls", secretName)
+	dnsNames, _, _ := unstructured.NestedStringSlice(certificate.Object, "spec", "dnsNames")
+	assert.Contains(t, dnsNames, workflow.Name+"."+workflow.Namespace+".svc")
+	issuerKind, _, _ := unstructured.NestedString(certificate.Object, "spec", "issuerRef", "kind")
+	assert.Equal(t, "ClusterIssuer", issuerKind)
+
+	object, err = DeploymentCreator(workflow, plf)
+	assert.NoError(t, err)
+	podSpec := object.(*appsv1.Deployment).Spec.Template.Spec
+	assert.Contains(t, podSpec.Volumes, corev1.Volume{
+		Name:         "tls",
+		VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: workflow.Name + "-tls"}},
+	})
+	container, _ := kubeutil.GetContainerByName(v1alpha08.DefaultContainerName, &podSpec)
+	port, _ := kubeutil.GetContainerPortByName("https", container)
+	assert.Equal(t, int32(8443), port.ContainerPort)
+
+	object, err = ServiceCreator(workflow)
+	assert.NoError(t, err)
+	service := object.(*corev1.Service)
+	assert.NoError(t, TLSServiceMutateVisitor(workflow, plf)(service)())
+	servicePort, _ := kubeutil.GetServicePortByName("https", service)
+	assert.Equal(t, int32(443), servicePort.Port)
+
+	workflow.Spec.PodTemplate.DeploymentModel = v1alpha08.KnativeDeploymentModel
+	object, err = CertificateCreator(workflow, plf)
+	assert.NoError(t, err)
+	assert.Nil(t, object)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
 	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/constants"
+	kubeutil "github.com/apache/incubator-kie-kogito-serverless-operator/utils/kubernetes"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -99,6 +100,22 @@ func ConfigurePostgreSQLEnv(postgresql *operatorapi.PersistencePostgreSQL, datab
 	}
 }
 
+// PostgreSQLServiceNamespace returns the namespace of the in-cluster PostgreSQL service, defaulting to databaseNamespace for
+// service references. An empty string is returned when the database doesn't run in the cluster.
+func PostgreSQLServiceNamespace(postgresql *operatorapi.PersistencePostgreSQL, databaseNamespace string) string {
+	if postgresql == nil {
+		return ""
+	}
+	if postgresql.ServiceRef != nil {
+		if len(postgresql.ServiceRef.Namespace) > 0 {
+			return postgresql.ServiceRef.Namespace
+		}
+		return databaseNamespace
+	}
+	namespace, _ := kubeutil.ClusterNamespaceFromURL(postgresql.JdbcUrl)
+	return namespace
+}
+
 func ConfigurePersistence(serviceContainer *corev1.Container, config *operatorapi.PersistenceOptionsSpec, defaultSchema, namespace string) *corev1.Container {
 	if config.PostgreSQL == nil {
 		return serviceContainer
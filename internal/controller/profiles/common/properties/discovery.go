@@ -75,6 +75,44 @@ func escapeValue(unescaped string, value string) string {
 func generateDiscoveryProperties(ctx context.Context, catalog discovery.ServiceCatalog, props *properties.Properties,
 	workflow *operatorapi.SonataFlow) *properties.Properties {
 	klog.V(log.I).Infof("Generating service discovery properties for workflow: %s, and namespace: %s.", workflow.Name, workflow.Namespace)
+	result := resolveDiscoveryProperties(ctx, catalog, props, workflow)
+
+	for _, function := range workflow.Spec.Flow.Functions {
+		klog.V(log.I).Infof("Scanning function: %s for service discovery configuration.", function.Name)
+		if strings.HasPrefix(function.Operation, knativeServiceOperationPrefix) {
+			klog.V(log.I).Infof("Function %s looks to be a knative service invocation on service: %s.", function.Name, function.Operation)
+			if uri, err := discovery.ParseUri(function.Operation); err != nil {
+				klog.V(log.I).Infof("Operation: %s not correspond to a valid service discovery configuration, it will be excluded from service discovery.", function.Operation)
+			} else {
+				if len(uri.Namespace) == 0 {
+					klog.V(log.I).Infof("Current operation has no configured namespace, workflow namespace: %s will be used instead.", workflow.Namespace)
+					uri.Namespace = workflow.Namespace
+				}
+				if address, err := catalog.Query(ctx, *uri, ""); err != nil {
+					klog.V(log.E).ErrorS(err, "An error was produced during service address resolution.", "serviceUri", function.Operation)
+				} else {
+					// when the knative service is invoked from the workflow as an Operation, the query params are not
+					// used for the microprofile property generation.
+					trimmedUri := function.Operation
+					if questionMarkIndex := strings.Index(trimmedUri, "?"); questionMarkIndex > 0 {
+						trimmedUri = function.Operation[:questionMarkIndex]
+					}
+					klog.V(log.I).Infof("Service: %s was resolved into the following address: %s.", function.Operation, address)
+					mpProperty := generateMicroprofileServiceCatalogProperty(trimmedUri)
+					klog.V(log.I).Infof("Generating microprofile service catalog property %s=%s.", mpProperty, address)
+					result.MustSet(mpProperty, address)
+				}
+			}
+		}
+	}
+	return result
+}
+
+// resolveDiscoveryProperties resolves the values of the given properties looking like a service discovery configuration,
+// e.g. ${knative:brokers.v1.eventing.knative.dev/my-namespace/default}. For every resolved property, the resolved address
+// and its MicroProfileConfigServiceCatalog property are returned.
+func resolveDiscoveryProperties(ctx context.Context, catalog discovery.ServiceCatalog, props *properties.Properties,
+	workflow *operatorapi.SonataFlow) *properties.Properties {
 	result := properties.NewProperties()
 	props.DisableExpansion = true
 	for _, k := range props.Keys() {
@@ -105,34 +143,5 @@ func generateDiscoveryProperties(ctx context.Context, catalog discovery.ServiceC
 			}
 		}
 	}
-
-	for _, function := range workflow.Spec.Flow.Functions {
-		klog.V(log.I).Infof("Scanning function: %s for service discovery configuration.", function.Name)
-		if strings.HasPrefix(function.Operation, knativeServiceOperationPrefix) {
-			klog.V(log.I).Infof("Function %s looks to be a knative service invocation on service: %s.", function.Name, function.Operation)
-			if uri, err := discovery.ParseUri(function.Operation); err != nil {
-				klog.V(log.I).Infof("Operation: %s not correspond to a valid service discovery configuration, it will be excluded from service discovery.", function.Operation)
-			} else {
-				if len(uri.Namespace) == 0 {
-					klog.V(log.I).Infof("Current operation has no configured namespace, workflow namespace: %s will be used instead.", workflow.Namespace)
-					uri.Namespace = workflow.Namespace
-				}
-				if address, err := catalog.Query(ctx, *uri, ""); err != nil {
-					klog.V(log.E).ErrorS(err, "An error was produced during service address resolution.", "serviceUri", function.Operation)
-				} else {
-					// when the knative service is invoked from the workflow as an Operation, the query params are not
-					// used for the microprofile property generation.
-					trimmedUri := function.Operation
-					if questionMarkIndex := strings.Index(trimmedUri, "?"); questionMarkIndex > 0 {
-						trimmedUri = function.Operation[:questionMarkIndex]
-					}
-					klog.V(log.I).Infof("Service: %s was resolved into the following address: %s.", function.Operation, address)
-					mpProperty := generateMicroprofileServiceCatalogProperty(trimmedUri)
-					klog.V(log.I).Infof("Generating microprofile service catalog property %s=%s.", mpProperty, address)
-					result.MustSet(mpProperty, address)
-				}
-			}
-		}
-	}
 	return result
 }
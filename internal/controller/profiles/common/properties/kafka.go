@@ -0,0 +1,64 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package properties
+
+import (
+	"github.com/magiconair/properties"
+	cncfmodel "github.com/serverlessworkflow/sdk-go/v2/model"
+
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/kafka"
+)
+
+// generateKafkaEventingWorkflowProperties returns the set of application properties required for the workflow to produce or consume
+// its events from Kafka. Every event type is bound to its own channel and topic.
+// Never nil.
+func generateKafkaEventingWorkflowProperties(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) (*properties.Properties, error) {
+	props := properties.NewProperties()
+	kafkaPlatform, err := kafka.GetWorkflowKafkaPlatform(workflow, platform)
+	if err != nil {
+		return nil, err
+	}
+	if kafkaPlatform == nil {
+		return props, nil
+	}
+	props.Merge(kafka.GetClientProperties(kafka.GetPlatformKafka(kafkaPlatform)))
+	for _, event := range workflow.Spec.Flow.Events {
+		topic := kafka.TopicName(kafkaPlatform, event.Type)
+		if event.Kind == cncfmodel.EventKindProduced {
+			if hasEventSink(workflow, event.Type) {
+				// sent to the destination of its sink instead
+				continue
+			}
+			props.Merge(kafka.GetOutgoingChannelProperties(event.Type, topic))
+		} else {
+			// the events are consumed unless stated otherwise
+			props.Merge(kafka.GetIncomingChannelProperties(event.Type, topic, kafka.ConsumerGroup(workflow)))
+		}
+	}
+	return props, nil
+}
+
+func hasEventSink(workflow *operatorapi.SonataFlow, eventType string) bool {
+	for _, sink := range workflow.Spec.Sinks {
+		if sink.EventType == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -18,12 +18,23 @@
 package properties
 
 import (
+	"fmt"
+
 	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/discovery"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/knative"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/constants"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/workflowdef"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
 	"github.com/magiconair/properties"
 	cncfmodel "github.com/serverlessworkflow/sdk-go/v2/model"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+const (
+	knativeServingGroup = "serving.knative.dev"
+	serviceKind         = "Service"
 )
 
 // generateKnativeEventingWorkflowProperties returns the set of application properties required for the workflow to produce or consume
@@ -51,3 +62,66 @@ func generateKnativeEventingWorkflowProperties(workflow *operatorapi.SonataFlow,
 	}
 	return props, nil
 }
+
+// generateEventSinksWorkflowProperties returns the set of application properties sending the produced events listed in the
+// workflow sinks to their own destination. The destinations referring to a cluster resource are configured as service
+// discovery URIs, resolved with the rest of the discovery properties.
+// Never nil.
+func generateEventSinksWorkflowProperties(workflow *operatorapi.SonataFlow) (*properties.Properties, error) {
+	props := properties.NewProperties()
+	if workflow == nil {
+		return props, nil
+	}
+	for _, sink := range workflow.Spec.Sinks {
+		if !isProducedEventType(workflow, sink.EventType) {
+			return nil, fmt.Errorf("sink event type %s doesn't match any produced event of the workflow %s", sink.EventType, workflow.Name)
+		}
+		url, err := sinkURL(&sink.Destination, workflow.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		props.Set(utils.MessagingChannelProperty(utils.OutgoingChannel, sink.EventType, "connector"), constants.QuarkusHTTP)
+		props.Set(utils.MessagingChannelProperty(utils.OutgoingChannel, sink.EventType, "url"), url)
+		props.Set(utils.MessagingChannelProperty(utils.OutgoingChannel, sink.EventType, "method"), constants.Post)
+	}
+	props.Sort()
+	return props, nil
+}
+
+func isProducedEventType(workflow *operatorapi.SonataFlow, eventType string) bool {
+	for _, event := range workflow.Spec.Flow.Events {
+		if event.Kind == cncfmodel.EventKindProduced && event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// sinkURL returns the URL of the given destination, or the service discovery URI of the resource it refers to.
+func sinkURL(dest *duckv1.Destination, namespace string) (string, error) {
+	if dest.Ref == nil {
+		if dest.URI == nil {
+			return "", fmt.Errorf("sink must define either a ref or an uri")
+		}
+		return dest.URI.String(), nil
+	}
+	gv, err := schema.ParseGroupVersion(dest.Ref.APIVersion)
+	if err != nil {
+		return "", err
+	}
+	var uriBuilder discovery.ResourceUriBuilder
+	switch {
+	case knative.IsKnativeBroker(dest.Ref):
+		uriBuilder = discovery.NewResourceUriBuilder(discovery.KnativeScheme).Kind("brokers").Version(gv.Version).Group(gv.Group)
+	case gv.Group == knativeServingGroup && dest.Ref.Kind == serviceKind:
+		uriBuilder = discovery.NewResourceUriBuilder(discovery.KnativeScheme).Kind("services").Version(gv.Version).Group(gv.Group)
+	case len(gv.Group) == 0 && dest.Ref.Kind == serviceKind:
+		uriBuilder = discovery.NewResourceUriBuilder(discovery.KubernetesScheme).Kind("services").Version(gv.Version)
+	default:
+		return "", fmt.Errorf("sink ref %s %s is not supported, only Knative Brokers, Knative Services and Kubernetes Services are", dest.Ref.APIVersion, dest.Ref.Kind)
+	}
+	if len(dest.Ref.Namespace) > 0 {
+		namespace = dest.Ref.Namespace
+	}
+	return "${" + uriBuilder.Namespace(namespace).Name(dest.Ref.Name).Build().String() + "}", nil
+}
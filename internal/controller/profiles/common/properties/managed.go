@@ -23,11 +23,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/certmanager"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/cfg"
 
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles"
 
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/persistence"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/security"
 
 	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
 
@@ -64,6 +66,8 @@ type managedPropertyHandler struct {
 	ctx                      context.Context
 	userProperties           string
 	defaultManagedProperties *properties.Properties
+	// eventSinkProperties are the managed properties sending the produced events to their own sink
+	eventSinkProperties *properties.Properties
 }
 
 func (a *managedPropertyHandler) WithUserProperties(properties string) ManagedPropertyHandler {
@@ -99,6 +103,7 @@ func (a *managedPropertyHandler) Build() string {
 	if a.requireServiceDiscovery() {
 		// produce the MicroProfileConfigServiceCatalog properties for the service discovery property values if any.
 		discoveryProps.Merge(generateDiscoveryProperties(a.ctx, a.catalog, userProps, a.workflow))
+		discoveryProps.Merge(resolveDiscoveryProperties(a.ctx, a.catalog, a.eventSinkProperties, a.workflow))
 	}
 	if profiles.IsDevProfile(a.workflow) && a.requireServiceDiscovery() {
 		// produce dev profile properties that must be calculated at service discovery time.
@@ -183,12 +188,27 @@ func NewManagedPropertyHandler(workflow *operatorapi.SonataFlow, platform *opera
 		}
 		props.Merge(p)
 	}
+	props.Merge(security.GetOIDCWorkflowProperties(workflow, platform))
+	props.Merge(security.GetFunctionAuthProperties(workflow))
+	if certmanager.UsesTLS(workflow, platform) {
+		props.Merge(certmanager.GetTLSProperties())
+	}
 
 	p, err := generateKnativeEventingWorkflowProperties(workflow, platform)
 	if err != nil {
 		return nil, err
 	}
 	props.Merge(p)
+	p, err = generateKafkaEventingWorkflowProperties(workflow, platform)
+	if err != nil {
+		return nil, err
+	}
+	props.Merge(p)
+	handler.eventSinkProperties, err = generateEventSinksWorkflowProperties(workflow)
+	if err != nil {
+		return nil, err
+	}
+	props.Merge(handler.eventSinkProperties)
 	props.Sort()
 
 	handler.defaultManagedProperties = props
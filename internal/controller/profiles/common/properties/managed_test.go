@@ -36,6 +36,10 @@ import (
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/constants"
 
 	"github.com/magiconair/properties"
+	cncfmodel "github.com/serverlessworkflow/sdk-go/v2/model"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	"github.com/stretchr/testify/assert"
 
@@ -836,3 +840,83 @@ func setJobServiceJDBC(jdbc string) plfmOptionFn {
 		p.Spec.Services.JobService.Persistence.PostgreSQL.JdbcUrl = jdbc
 	}
 }
+
+func Test_appPropertyHandler_WithFunctionAuth(t *testing.T) {
+	workflow := test.GetBaseSonataFlow("default")
+	workflow.Spec.Flow.Functions = []cncfmodel.Function{{Name: "getPets", Operation: "specs/petstore.yaml#getPets", AuthRef: "petstore_auth"}}
+	workflow.Spec.Flow.Auth = cncfmodel.Auths{{Name: "petstore_auth", Scheme: cncfmodel.AuthTypeBearer, Properties: cncfmodel.AuthProperties{
+		Bearer: &cncfmodel.BearerAuthProperties{Secret: "petstore-secret", Token: "$SECRET.token"},
+	}}}
+	props, err := NewManagedPropertyHandler(workflow, test.GetBasePlatform())
+	assert.NoError(t, err)
+	generatedProps, propsErr := properties.LoadString(props.Build())
+	assert.NoError(t, propsErr)
+	generatedProps.DisableExpansion = true
+	assert.Equal(t, "${SONATAFLOW_AUTH_PETSTORE_AUTH_TOKEN}", generatedProps.GetString("quarkus.openapi-generator.petstore_yaml.auth.petstore_auth.bearer-token", ""))
+}
+
+func Test_appPropertyHandler_WithKafkaEventing(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithProdProfile("default")
+	workflow.Spec.Flow.Events = []cncfmodel.Event{
+		{Name: "orderPlaced", Type: "org.acme.order.placed", Kind: cncfmodel.EventKindConsumed},
+		{Name: "orderShipped", Type: "orderShipped", Kind: cncfmodel.EventKindProduced},
+	}
+	platform := test.GetBasePlatform()
+	platform.Spec.Eventing = &operatorapi.PlatformEventingSpec{
+		Kafka: &operatorapi.PlatformKafkaEventingSpec{
+			BootstrapServers: "my-cluster-kafka-bootstrap.kafka:9093",
+			TopicNaming:      operatorapi.NamespacePrefixedKafkaTopicNaming,
+			Security: &operatorapi.KafkaSecuritySpec{
+				Protocol:          operatorapi.KafkaSASLSSLSecurityProtocol,
+				SASLMechanism:     "SCRAM-SHA-512",
+				SASLJAASConfigRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "kafka-user"}, Key: "sasl.jaas.config"},
+			},
+		},
+	}
+	props, err := NewManagedPropertyHandler(workflow, platform)
+	assert.NoError(t, err)
+	generatedProps, propsErr := properties.LoadString(props.Build())
+	assert.NoError(t, propsErr)
+	generatedProps.DisableExpansion = true
+	assert.Equal(t, "my-cluster-kafka-bootstrap.kafka:9093", generatedProps.GetString("kafka.bootstrap.servers", ""))
+	assert.Equal(t, "SASL_SSL", generatedProps.GetString("kafka.security.protocol", ""))
+	assert.Equal(t, "SCRAM-SHA-512", generatedProps.GetString("kafka.sasl.mechanism", ""))
+	assert.Equal(t, "${KAFKA_SASL_JAAS_CONFIG}", generatedProps.GetString("kafka.sasl.jaas.config", ""))
+	assert.Equal(t, "smallrye-kafka", generatedProps.GetString(`mp.messaging.incoming."org.acme.order.placed".connector`, ""))
+	assert.Equal(t, platform.Namespace+".org.acme.order.placed", generatedProps.GetString(`mp.messaging.incoming."org.acme.order.placed".topic`, ""))
+	assert.Equal(t, workflow.Namespace+"."+workflow.Name, generatedProps.GetString(`mp.messaging.incoming."org.acme.order.placed".group.id`, ""))
+	assert.Equal(t, "smallrye-kafka", generatedProps.GetString("mp.messaging.outgoing.orderShipped.connector", ""))
+	assert.Equal(t, platform.Namespace+".orderShipped", generatedProps.GetString("mp.messaging.outgoing.orderShipped.topic", ""))
+	assert.Equal(t, "false", generatedProps.GetString(constants.KnativeHealthEnabled, ""))
+	_, found := generatedProps.Get(constants.KogitoIncomingEventsConnector)
+	assert.False(t, found)
+}
+
+func Test_appPropertyHandler_WithEventSinks(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithProdProfile(defaultNamespace)
+	workflow.Spec.Flow.Events = []cncfmodel.Event{
+		{Name: "orderShipped", Type: "org.acme.order.shipped", Kind: cncfmodel.EventKindProduced},
+		{Name: "orderCancelled", Type: "orderCancelled", Kind: cncfmodel.EventKindProduced},
+	}
+	uri, _ := apis.ParseURL("http://audit.example.com/events")
+	workflow.Spec.Sinks = []operatorapi.SonataFlowSinkSpec{
+		{EventType: "org.acme.order.shipped", Destination: duckv1.Destination{Ref: &duckv1.KReference{
+			Kind: "Broker", APIVersion: "eventing.knative.dev/v1", Name: myKnBroker1, Namespace: namespace1,
+		}}},
+		{EventType: "orderCancelled", Destination: duckv1.Destination{URI: uri}},
+	}
+	handler, err := NewManagedPropertyHandler(workflow, test.GetBasePlatform())
+	assert.NoError(t, err)
+	generatedProps, propsErr := properties.LoadString(handler.WithServiceDiscovery(context.TODO(), &mockCatalogService{}).Build())
+	assert.NoError(t, propsErr)
+	generatedProps.DisableExpansion = true
+	assert.Equal(t, "quarkus-http", generatedProps.GetString(`mp.messaging.outgoing."org.acme.order.shipped".connector`, ""))
+	assert.Equal(t, myKnBroker1Address, generatedProps.GetString(`mp.messaging.outgoing."org.acme.order.shipped".url`, ""))
+	assertHasProperty(t, generatedProps, "org.kie.kogito.addons.discovery.knative:brokers.v1.eventing.knative.dev/namespace1/my-kn-broker1", myKnBroker1Address)
+	assert.Equal(t, "quarkus-http", generatedProps.GetString("mp.messaging.outgoing.orderCancelled.connector", ""))
+	assert.Equal(t, "http://audit.example.com/events", generatedProps.GetString("mp.messaging.outgoing.orderCancelled.url", ""))
+
+	workflow.Spec.Sinks[1].EventType = "orderDelivered"
+	_, err = NewManagedPropertyHandler(workflow, test.GetBasePlatform())
+	assert.ErrorContains(t, err, "sink event type orderDelivered doesn't match any produced event")
+}
@@ -0,0 +1,189 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api"
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/platform"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/constants"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/log"
+	kubeutil "github.com/apache/incubator-kie-kogito-serverless-operator/utils/kubernetes"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/workflowproj"
+)
+
+// RecoveryPolicyGetter returns the recovery policy of the given platform, the platform might be nil.
+// A nil policy recovers the workflow with the default policy values.
+type RecoveryPolicyGetter func(plf *operatorapi.SonataFlowPlatform) *operatorapi.RecoveryPolicySpec
+
+// WorkflowResetter resets the workflow conditions, so the profile reconciliation creates the removed objects again.
+type WorkflowResetter func(workflow *operatorapi.SonataFlow)
+
+// NewRecoverFromFailureState creates the reconciliation state recovering a failed workflow Deployment with the policy returned by getPolicy.
+func NewRecoverFromFailureState(support *StateSupport, getPolicy RecoveryPolicyGetter, reset WorkflowResetter) *RecoverFromFailureState {
+	return &RecoverFromFailureState{StateSupport: support, getPolicy: getPolicy, reset: reset}
+}
+
+// RecoverFromFailureState tries to recover a workflow whose Deployment has failed, taking the actions of the platform recovery policy
+// until the Deployment is available again or the maximum attempts are exhausted.
+type RecoverFromFailureState struct {
+	*StateSupport
+	getPolicy RecoveryPolicyGetter
+	reset     WorkflowResetter
+}
+
+func (r *RecoverFromFailureState) CanReconcile(workflow *operatorapi.SonataFlow) bool {
+	return workflow.Status.GetCondition(api.RunningConditionType).IsFalse()
+}
+
+// GetPolicy returns the recovery policy of the workflow platform.
+func (r *RecoverFromFailureState) GetPolicy(ctx context.Context, workflow *operatorapi.SonataFlow) (*operatorapi.RecoveryPolicySpec, error) {
+	pl, err := platform.GetActivePlatform(ctx, r.C, workflow.Namespace)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	return r.getPolicy(pl), nil
+}
+
+func (r *RecoverFromFailureState) Do(ctx context.Context, workflow *operatorapi.SonataFlow) (ctrl.Result, []client.Object, error) {
+	deployment := &appsv1.Deployment{}
+	if err := r.C.Get(ctx, client.ObjectKeyFromObject(workflow), deployment); err != nil {
+		// if the deployment is not there, let's try to reset the status condition and make the reconciliation fix the objects
+		if errors.IsNotFound(err) {
+			klog.V(log.I).InfoS("Tried to recover from failed state, no deployment found, trying to reset the workflow conditions")
+			r.reset(workflow)
+			if _, updateErr := r.PerformStatusUpdate(ctx, workflow); updateErr != nil {
+				return ctrl.Result{Requeue: false}, nil, updateErr
+			}
+			return ctrl.Result{RequeueAfter: constants.RequeueAfterFailure}, nil, nil
+		}
+		return ctrl.Result{Requeue: false}, nil, err
+	}
+
+	// if the deployment is progressing we might have good news
+	if kubeutil.IsDeploymentAvailable(deployment) {
+		workflow.Status.RecoverFailureAttempts = 0
+		workflow.Status.Manager().MarkTrue(api.RunningConditionType)
+		if _, updateErr := r.PerformStatusUpdate(ctx, workflow); updateErr != nil {
+			return ctrl.Result{Requeue: false}, nil, updateErr
+		}
+		return ctrl.Result{RequeueAfter: constants.RequeueAfterFailure}, nil, nil
+	}
+
+	policy, err := r.GetPolicy(ctx, workflow)
+	if err != nil {
+		return ctrl.Result{}, nil, err
+	}
+	if workflow.Status.RecoverFailureAttempts >= policy.GetMaxAttempts() {
+		workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.RedeploymentExhaustedReason,
+			"Can't recover workflow from failure after maximum attempts: %d", workflow.Status.RecoverFailureAttempts)
+		if _, updateErr := r.PerformStatusUpdate(ctx, workflow); updateErr != nil {
+			return ctrl.Result{}, nil, updateErr
+		}
+		return ctrl.Result{RequeueAfter: policy.GetBackoff(workflow.Status.RecoverFailureAttempts)}, nil, nil
+	}
+
+	// Guard to avoid consecutive reconciliations to mess with the recover interval
+	if !workflow.Status.LastTimeRecoverAttempt.IsZero() {
+		if wait := policy.GetBackoff(workflow.Status.RecoverFailureAttempts) - metav1.Now().Sub(workflow.Status.LastTimeRecoverAttempt.Time); wait > 0 {
+			return ctrl.Result{RequeueAfter: wait}, nil, nil
+		}
+	}
+
+	action := policy.GetAction(workflow.Status.RecoverFailureAttempts)
+	klog.V(log.I).InfoS("Trying to recover the workflow from failure", "Workflow", workflow.Name, "Action", action, "Attempt", workflow.Status.RecoverFailureAttempts+1)
+	resetWorkflow, err := r.takeRecoveryAction(ctx, workflow, deployment, action)
+	if err != nil {
+		klog.V(log.E).ErrorS(err, "Error during the recovery action", "Action", action)
+		return ctrl.Result{RequeueAfter: constants.RequeueRecoverDeploymentErrorInterval}, nil, nil
+	}
+
+	workflow.Status.RecoverFailureAttempts += 1
+	workflow.Status.LastTimeRecoverAttempt = metav1.Now()
+	if resetWorkflow {
+		r.reset(workflow)
+	}
+	if _, err := r.PerformStatusUpdate(ctx, workflow); err != nil {
+		return ctrl.Result{Requeue: false}, nil, err
+	}
+
+	return ctrl.Result{RequeueAfter: constants.RequeueAfterFailure}, nil, nil
+}
+
+// takeRecoveryAction takes the given recovery action, returns true if the workflow must be reset to recreate the removed objects.
+func (r *RecoverFromFailureState) takeRecoveryAction(ctx context.Context, workflow *operatorapi.SonataFlow, deployment *appsv1.Deployment, action operatorapi.RecoveryAction) (bool, error) {
+	switch action {
+	case operatorapi.RecreateDeploymentRecoveryAction:
+		return true, client.IgnoreNotFound(r.C.Delete(ctx, deployment, client.PropagationPolicy(metav1.DeletePropagationBackground)))
+	case operatorapi.ResetConfigMapsRecoveryAction:
+		if err := r.deleteGeneratedConfigMaps(ctx, workflow); err != nil {
+			return false, err
+		}
+		return true, r.rolloutDeployment(ctx, deployment)
+	default:
+		return false, r.rolloutDeployment(ctx, deployment)
+	}
+}
+
+func (r *RecoverFromFailureState) rolloutDeployment(ctx context.Context, deployment *appsv1.Deployment) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := r.C.Get(ctx, client.ObjectKeyFromObject(deployment), deployment); err != nil {
+			return err
+		}
+		if err := kubeutil.MarkDeploymentToRollout(deployment); err != nil {
+			return err
+		}
+		return r.C.Update(ctx, deployment)
+	})
+}
+
+// deleteGeneratedConfigMaps deletes the workflow definition and the managed properties ConfigMaps, if they are owned by the workflow.
+func (r *RecoverFromFailureState) deleteGeneratedConfigMaps(ctx context.Context, workflow *operatorapi.SonataFlow) error {
+	for _, name := range []string{workflow.Name, workflowproj.GetWorkflowManagedPropertiesConfigMapName(workflow)} {
+		cm := &corev1.ConfigMap{}
+		if err := r.C.Get(ctx, client.ObjectKey{Namespace: workflow.Namespace, Name: name}, cm); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if !metav1.IsControlledBy(cm, workflow) {
+			continue
+		}
+		if err := r.C.Delete(ctx, cm); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *RecoverFromFailureState) PostReconcile(ctx context.Context, workflow *operatorapi.SonataFlow) error {
+	//By default, we don't want to perform anything after the reconciliation, and so we will simply return no error
+	return nil
+}
@@ -0,0 +1,121 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api/metadata"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/test"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/workflowproj"
+)
+
+func TestRecoveryPolicySpec_Defaults(t *testing.T) {
+	var policy *v1alpha08.RecoveryPolicySpec
+	assert.Equal(t, 3, policy.GetMaxAttempts())
+	assert.Equal(t, 10*time.Minute, policy.GetBackoff(1))
+	assert.Equal(t, v1alpha08.RestartPodRecoveryAction, policy.GetAction(5))
+
+	policy = &v1alpha08.RecoveryPolicySpec{
+		Backoff: &metav1.Duration{Duration: time.Minute},
+		Actions: []v1alpha08.RecoveryAction{v1alpha08.RestartPodRecoveryAction, v1alpha08.RecreateDeploymentRecoveryAction},
+	}
+	assert.Equal(t, 4*time.Minute, policy.GetBackoff(3))
+	// the backoff doesn't overflow after many attempts
+	assert.Equal(t, v1alpha08.MaxRecoveryBackoff, policy.GetBackoff(1000))
+	assert.Equal(t, v1alpha08.RestartPodRecoveryAction, policy.GetAction(0))
+	assert.Equal(t, v1alpha08.RecreateDeploymentRecoveryAction, policy.GetAction(1))
+	assert.Equal(t, v1alpha08.RecreateDeploymentRecoveryAction, policy.GetAction(2))
+}
+
+func TestRecoverFromFailureState_EscalatesActions(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithPreviewProfile(t.Name())
+	workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.DeploymentFailureReason, "")
+	platform := test.GetBasePlatformInReadyPhase(workflow.Namespace)
+	newDeployment := func() *appsv1.Deployment {
+		return &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: workflow.Name, Namespace: workflow.Namespace}}
+	}
+	userCM := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: workflow.Name, Namespace: workflow.Namespace}}
+	managedCM := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: workflowproj.GetWorkflowManagedPropertiesConfigMapName(workflow), Namespace: workflow.Namespace}}
+	cli := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(workflow, platform, newDeployment(), userCM).
+		WithStatusSubresource(workflow, platform).Build()
+	assert.NoError(t, controllerutil.SetControllerReference(workflow, managedCM, cli.Scheme()))
+	assert.NoError(t, cli.Create(context.TODO(), managedCM))
+
+	policy := &v1alpha08.RecoveryPolicySpec{
+		Backoff: &metav1.Duration{},
+		Actions: []v1alpha08.RecoveryAction{
+			v1alpha08.RestartPodRecoveryAction, v1alpha08.RecreateDeploymentRecoveryAction, v1alpha08.ResetConfigMapsRecoveryAction,
+		},
+	}
+	resets := 0
+	state := NewRecoverFromFailureState(&StateSupport{C: cli},
+		func(plf *v1alpha08.SonataFlowPlatform) *v1alpha08.RecoveryPolicySpec { return policy },
+		func(workflow *v1alpha08.SonataFlow) { resets++ })
+	assert.True(t, state.CanReconcile(workflow))
+
+	// first attempt restarts the pods
+	_, _, err := state.Do(context.TODO(), workflow)
+	assert.NoError(t, err)
+	deployment := newDeployment()
+	assert.NoError(t, cli.Get(context.TODO(), client.ObjectKeyFromObject(deployment), deployment))
+	assert.NotEmpty(t, deployment.Spec.Template.Annotations[metadata.RestartedAt])
+	assert.Equal(t, 1, workflow.Status.RecoverFailureAttempts)
+	assert.Equal(t, 0, resets)
+
+	// second attempt recreates the deployment
+	_, _, err = state.Do(context.TODO(), workflow)
+	assert.NoError(t, err)
+	assert.True(t, errors.IsNotFound(cli.Get(context.TODO(), client.ObjectKeyFromObject(deployment), deployment)))
+	assert.Equal(t, 2, workflow.Status.RecoverFailureAttempts)
+	assert.Equal(t, 1, resets)
+
+	// third attempt removes only the ConfigMaps owned by the workflow
+	assert.NoError(t, cli.Create(context.TODO(), newDeployment()))
+	_, _, err = state.Do(context.TODO(), workflow)
+	assert.NoError(t, err)
+	assert.True(t, errors.IsNotFound(cli.Get(context.TODO(), client.ObjectKeyFromObject(managedCM), managedCM)))
+	assert.NoError(t, cli.Get(context.TODO(), client.ObjectKeyFromObject(userCM), userCM))
+	assert.Equal(t, 3, workflow.Status.RecoverFailureAttempts)
+	assert.Equal(t, 2, resets)
+
+	// no more attempts
+	policy.MaxAttempts = utils.Pint(3)
+	workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.DeploymentFailureReason, "")
+	_, _, err = state.Do(context.TODO(), workflow)
+	assert.NoError(t, err)
+	assert.Equal(t, api.RedeploymentExhaustedReason, workflow.Status.GetCondition(api.RunningConditionType).Reason)
+	assert.Equal(t, 3, workflow.Status.RecoverFailureAttempts)
+	assert.Equal(t, 2, resets)
+}
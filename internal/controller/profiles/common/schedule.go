@@ -0,0 +1,76 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package common
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	cncfmodel "github.com/serverlessworkflow/sdk-go/v2/model"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+)
+
+// nextScheduleTime computes the next time after now the given cron starts the workflow.
+// Returns nil once the cron validUntil is reached, meaning that the workflow must no longer be started.
+func nextScheduleTime(schedule *cncfmodel.Schedule, now time.Time) (*time.Time, error) {
+	spec := schedule.Cron.Expression
+	if len(schedule.Timezone) > 0 {
+		spec = fmt.Sprintf("CRON_TZ=%s %s", schedule.Timezone, spec)
+	}
+	parsed, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid workflow start cron %q: %w", schedule.Cron.Expression, err)
+	}
+	next := parsed.Next(now)
+	if len(schedule.Cron.ValidUntil) > 0 {
+		validUntil, err := time.Parse(time.RFC3339, schedule.Cron.ValidUntil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid workflow start cron validUntil %q: %w", schedule.Cron.ValidUntil, err)
+		}
+		if next.After(validUntil) {
+			return nil, nil
+		}
+	}
+	return &next, nil
+}
+
+// SyncScheduleStatus reflects the last and next times the workflow is started by the given CronJob in the workflow status.
+// The status is cleared if the flow no longer defines a cron start. The caller must persist it.
+func SyncScheduleStatus(workflow *operatorapi.SonataFlow, cronJob *batchv1.CronJob, now time.Time) error {
+	if workflow.GetStartCron() == nil || cronJob == nil {
+		workflow.Status.Schedule = nil
+		return nil
+	}
+	status := &operatorapi.ScheduleStatus{
+		Cron:            workflow.GetStartCron().Expression,
+		LastTriggerTime: cronJob.Status.LastScheduleTime,
+	}
+	next, err := nextScheduleTime(workflow.Spec.Flow.Start.Schedule, now)
+	if err != nil {
+		return err
+	}
+	if next != nil {
+		status.NextTriggerTime = &metav1.Time{Time: *next}
+	}
+	workflow.Status.Schedule = status
+	return nil
+}
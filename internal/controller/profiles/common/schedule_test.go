@@ -0,0 +1,124 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	cncfmodel "github.com/serverlessworkflow/sdk-go/v2/model"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/test"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/workflowproj"
+)
+
+func Test_nextScheduleTime(t *testing.T) {
+	now := time.Date(2024, 5, 10, 10, 30, 0, 0, time.UTC)
+	schedule := &cncfmodel.Schedule{Cron: &cncfmodel.Cron{Expression: "0 * * * *"}}
+
+	next, err := nextScheduleTime(schedule, now)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 5, 10, 11, 0, 0, 0, time.UTC), next.UTC())
+
+	schedule.Timezone = "Asia/Kolkata"
+	schedule.Cron.Expression = "0 12 * * *"
+	next, err = nextScheduleTime(schedule, now)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 5, 11, 6, 30, 0, 0, time.UTC), next.UTC())
+
+	// the next trigger is past the cron validity
+	schedule.Cron.ValidUntil = "2024-05-11T00:00:00Z"
+	next, err = nextScheduleTime(schedule, now)
+	assert.NoError(t, err)
+	assert.Nil(t, next)
+
+	schedule.Cron.Expression = "not a cron"
+	_, err = nextScheduleTime(schedule, now)
+	assert.Error(t, err)
+}
+
+func Test_SyncScheduleStatus(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithPreviewProfile(t.Name())
+	workflow.Spec.Flow.Start = &cncfmodel.Start{
+		StateName: "ChooseOnLanguage",
+		Schedule:  &cncfmodel.Schedule{Cron: &cncfmodel.Cron{Expression: "*/5 * * * *"}},
+	}
+	lastSchedule := metav1.NewTime(time.Date(2024, 5, 10, 10, 25, 0, 0, time.UTC))
+	cronJob := &batchv1.CronJob{Status: batchv1.CronJobStatus{LastScheduleTime: &lastSchedule}}
+
+	assert.NoError(t, SyncScheduleStatus(workflow, cronJob, time.Date(2024, 5, 10, 10, 27, 0, 0, time.UTC)))
+	assert.Equal(t, "*/5 * * * *", workflow.Status.Schedule.Cron)
+	assert.Equal(t, &lastSchedule, workflow.Status.Schedule.LastTriggerTime)
+	assert.Equal(t, time.Date(2024, 5, 10, 10, 30, 0, 0, time.UTC), workflow.Status.Schedule.NextTriggerTime.UTC())
+
+	workflow.Spec.Flow.Start.Schedule = nil
+	assert.NoError(t, SyncScheduleStatus(workflow, cronJob, time.Now()))
+	assert.Nil(t, workflow.Status.Schedule)
+}
+
+func TestCronJobCreator(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithPreviewProfile(t.Name())
+	cronJob, err := CronJobCreator(workflow, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, cronJob)
+
+	workflow.Spec.Flow.Start = &cncfmodel.Start{
+		StateName: "ChooseOnLanguage",
+		Schedule: &cncfmodel.Schedule{
+			Cron:     &cncfmodel.Cron{Expression: "0 8 * * 1-5"},
+			Timezone: "Europe/Rome",
+		},
+	}
+	cronJob, err = CronJobCreator(workflow, nil)
+	assert.NoError(t, err)
+	spec := cronJob.(*batchv1.CronJob).Spec
+	assert.Equal(t, "0 8 * * 1-5", spec.Schedule)
+	assert.Equal(t, "Europe/Rome", *spec.TimeZone)
+	assert.False(t, *spec.Suspend)
+	pod := spec.JobTemplate.Spec.Template
+	// the trigger pods must not be selected by the workflow Service
+	assert.NotEqual(t, workflow.Name, pod.Labels[workflowproj.LabelWorkflow])
+	assert.Equal(t, []corev1.EnvVar{{Name: "WORKFLOW_URL", Value: "http://" + workflow.Name + "." + workflow.Namespace + "/" + workflow.Name}}, pod.Spec.Containers[0].Env)
+
+	// the trigger authenticates with the workflow OIDC client credentials
+	workflow.Spec.Security = &v1alpha08.SecuritySpec{OIDC: &v1alpha08.OIDCSpec{
+		AuthServerURL:   "https://keycloak.example.com/realms/sonataflow",
+		ClientID:        "greeting",
+		ClientSecretRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "greeting-oidc"}, Key: "secret"},
+	}}
+	cronJob, err = CronJobCreator(workflow, nil)
+	assert.NoError(t, err)
+	env := cronJob.(*batchv1.CronJob).Spec.JobTemplate.Spec.Template.Spec.Containers[0].Env
+	assert.Len(t, env, 4)
+	assert.Equal(t, corev1.EnvVar{Name: "OIDC_AUTH_SERVER_URL", Value: "https://keycloak.example.com/realms/sonataflow"}, env[1])
+	assert.Equal(t, corev1.EnvVar{Name: "OIDC_CLIENT_ID", Value: "greeting"}, env[2])
+	assert.Equal(t, "greeting-oidc", env[3].ValueFrom.SecretKeyRef.Name)
+
+	// an expired schedule suspends the CronJob
+	workflow.Spec.Flow.Start.Schedule.Cron.ValidUntil = "2020-01-01T00:00:00Z"
+	cronJob, err = CronJobCreator(workflow, nil)
+	assert.NoError(t, err)
+	assert.True(t, *cronJob.(*batchv1.CronJob).Spec.Suspend)
+}
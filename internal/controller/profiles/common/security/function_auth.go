@@ -0,0 +1,270 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package security
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/magiconair/properties"
+	cncfmodel "github.com/serverlessworkflow/sdk-go/v2/model"
+	corev1 "k8s.io/api/core/v1"
+
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/cfg"
+)
+
+const (
+	quarkusOpenAPIGeneratorPrefix = "quarkus.openapi-generator."
+	quarkusOIDCClientPrefix       = "quarkus.oidc-client."
+	// functionAuthEnvPrefix prefixes the env variables holding the function auth values read from Secrets.
+	functionAuthEnvPrefix = "SONATAFLOW_AUTH_"
+	// secretValuePrefix marks an auth value read from the key of the Secret referenced by the auth definition, for example, $SECRET.password.
+	secretValuePrefix = "$SECRET."
+	// knativeOperationPrefix marks the functions invoking Knative services, which have no OpenAPI document.
+	knativeOperationPrefix     = "knative:"
+	oidcClientCredentialsGrant = "client"
+)
+
+var nonAlphanumeric = regexp.MustCompile("[^a-zA-Z0-9]")
+
+// functionAuth is an auth definition of the flow referenced by the OpenAPI functions.
+type functionAuth struct {
+	auth cncfmodel.Auth
+	// resources are the keys of the OpenAPI documents called with this auth, as expected by the Quarkus OpenAPI Generator.
+	resources []string
+}
+
+// GetFunctionAuthProperties returns the set of application properties configuring the Quarkus OpenAPI Generator with the
+// flow auth definitions referenced by the OpenAPI functions. The values read from Secrets are expanded at runtime from
+// the env variables returned by ConfigureFunctionAuthEnv.
+// Never nil.
+func GetFunctionAuthProperties(workflow *operatorapi.SonataFlow) *properties.Properties {
+	props := properties.NewProperties()
+	// the env variables are expanded by Quarkus at runtime
+	props.DisableExpansion = true
+	for _, fa := range resolveFunctionAuths(workflow) {
+		auth := fa.auth
+		switch auth.Scheme {
+		case cncfmodel.AuthTypeBasic:
+			for _, resource := range fa.resources {
+				prefix := openAPIGeneratorAuthPrefix(resource, auth.Name)
+				props.Set(prefix+"username", authPropertyValue(auth, "username", auth.Properties.Basic.Username))
+				props.Set(prefix+"password", authPropertyValue(auth, "password", auth.Properties.Basic.Password))
+			}
+		case cncfmodel.AuthTypeBearer:
+			for _, resource := range fa.resources {
+				props.Set(openAPIGeneratorAuthPrefix(resource, auth.Name)+"bearer-token", authPropertyValue(auth, "token", auth.Properties.Bearer.Token))
+			}
+		case cncfmodel.AuthTypeOAuth2:
+			// the OpenAPI Generator takes the tokens from the OIDC client named after the security scheme
+			oauth2 := auth.Properties.OAuth2
+			prefix := quarkusOIDCClientPrefix + auth.Name + "."
+			props.Set(prefix+"auth-server-url", oauth2.Authority)
+			props.Set(prefix+"client-id", authPropertyValue(auth, "clientId", oauth2.ClientID))
+			props.Set(prefix+"credentials.secret", authPropertyValue(auth, "clientSecret", oauth2.ClientSecret))
+			props.Set(prefix+"grant.type", oidcClientCredentialsGrant)
+			if len(oauth2.Scopes) > 0 {
+				props.Set(prefix+"scopes", strings.Join(oauth2.Scopes, ","))
+			}
+		}
+	}
+	props.Sort()
+	return props
+}
+
+// UsesFunctionOIDCClient returns true if the workflow calls OpenAPI functions authenticated with OAuth2 client credentials,
+// which take their tokens from the Quarkus OIDC client.
+func UsesFunctionOIDCClient(workflow *operatorapi.SonataFlow) bool {
+	for _, fa := range resolveFunctionAuths(workflow) {
+		if fa.auth.Scheme == cncfmodel.AuthTypeOAuth2 {
+			return true
+		}
+	}
+	return false
+}
+
+// GetOIDCClientExtensions returns the Quarkus extensions required to authenticate the OpenAPI functions with OAuth2.
+func GetOIDCClientExtensions() []cfg.GAV {
+	return cfg.GetCfg().OIDCClientExtensions
+}
+
+// ConfigureFunctionAuthEnv returns the env variables holding the flow auth values read from Secrets.
+func ConfigureFunctionAuthEnv(workflow *operatorapi.SonataFlow) []corev1.EnvVar {
+	var envs []corev1.EnvVar
+	for _, fa := range resolveFunctionAuths(workflow) {
+		auth := fa.auth
+		secret := authSecret(auth)
+		if len(secret) == 0 {
+			continue
+		}
+		for _, field := range authFields(auth) {
+			key, ok := secretKey(field.value)
+			if !ok {
+				continue
+			}
+			envs = append(envs, corev1.EnvVar{
+				Name: functionAuthEnvName(auth.Name, field.name),
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: secret},
+						Key:                  key,
+					},
+				},
+			})
+		}
+	}
+	return envs
+}
+
+// resolveFunctionAuths returns the supported auth definitions referenced by the OpenAPI functions of the flow, sorted by name.
+func resolveFunctionAuths(workflow *operatorapi.SonataFlow) []functionAuth {
+	auths := map[string]*functionAuth{}
+	for _, function := range workflow.Spec.Flow.Functions {
+		if len(function.AuthRef) == 0 || !isOpenAPIFunction(function) {
+			continue
+		}
+		resource, _, found := strings.Cut(function.Operation, "#")
+		if !found {
+			continue
+		}
+		fa, ok := auths[function.AuthRef]
+		if !ok {
+			auth := findAuth(workflow.Spec.Flow.Auth, function.AuthRef)
+			if auth == nil || !isSupportedAuth(auth) {
+				continue
+			}
+			fa = &functionAuth{auth: *auth}
+			auths[function.AuthRef] = fa
+		}
+		key := openAPIResourceKey(resource)
+		if !containsString(fa.resources, key) {
+			fa.resources = append(fa.resources, key)
+		}
+	}
+	result := make([]functionAuth, 0, len(auths))
+	for _, fa := range auths {
+		sort.Strings(fa.resources)
+		result = append(result, *fa)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].auth.Name < result[j].auth.Name })
+	return result
+}
+
+func isOpenAPIFunction(function cncfmodel.Function) bool {
+	return (len(function.Type) == 0 || function.Type == cncfmodel.FunctionTypeREST) && !strings.HasPrefix(function.Operation, knativeOperationPrefix)
+}
+
+func findAuth(auths cncfmodel.Auths, name string) *cncfmodel.Auth {
+	for i := range auths {
+		if auths[i].Name == name {
+			return &auths[i]
+		}
+	}
+	return nil
+}
+
+// isSupportedAuth returns true if the auth definition can be translated into the Quarkus configuration.
+// Only the client credentials grant is supported for OAuth2.
+func isSupportedAuth(auth *cncfmodel.Auth) bool {
+	switch auth.Scheme {
+	case cncfmodel.AuthTypeBasic:
+		return auth.Properties.Basic != nil
+	case cncfmodel.AuthTypeBearer:
+		return auth.Properties.Bearer != nil
+	case cncfmodel.AuthTypeOAuth2:
+		return auth.Properties.OAuth2 != nil && auth.Properties.OAuth2.GrantType == cncfmodel.GrantTypeClientCredentials
+	}
+	return false
+}
+
+type authField struct {
+	name  string
+	value string
+}
+
+func authFields(auth cncfmodel.Auth) []authField {
+	switch auth.Scheme {
+	case cncfmodel.AuthTypeBasic:
+		return []authField{{"username", auth.Properties.Basic.Username}, {"password", auth.Properties.Basic.Password}}
+	case cncfmodel.AuthTypeBearer:
+		return []authField{{"token", auth.Properties.Bearer.Token}}
+	case cncfmodel.AuthTypeOAuth2:
+		return []authField{{"clientId", auth.Properties.OAuth2.ClientID}, {"clientSecret", auth.Properties.OAuth2.ClientSecret}}
+	}
+	return nil
+}
+
+func authSecret(auth cncfmodel.Auth) string {
+	switch auth.Scheme {
+	case cncfmodel.AuthTypeBasic:
+		return auth.Properties.Basic.Secret
+	case cncfmodel.AuthTypeBearer:
+		return auth.Properties.Bearer.Secret
+	case cncfmodel.AuthTypeOAuth2:
+		return auth.Properties.OAuth2.Secret
+	}
+	return ""
+}
+
+// authPropertyValue returns the property value of the given auth field: an expression of the env variable holding it
+// if it's read from a Secret, the value itself otherwise.
+func authPropertyValue(auth cncfmodel.Auth, field, value string) string {
+	if _, ok := secretKey(value); ok && len(authSecret(auth)) > 0 {
+		return fmt.Sprintf("${%s}", functionAuthEnvName(auth.Name, field))
+	}
+	return value
+}
+
+// secretKey returns the Secret key of the given auth value, if it's of the form $SECRET.key or ${ $SECRET.key }.
+func secretKey(value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "${") && strings.HasSuffix(value, "}") {
+		value = strings.TrimSpace(value[2 : len(value)-1])
+	}
+	key, found := strings.CutPrefix(value, secretValuePrefix)
+	return key, found && len(key) > 0
+}
+
+// openAPIResourceKey returns the key used by the Quarkus OpenAPI Generator for the given OpenAPI document, for example,
+// specs/petstore.yaml is keyed petstore_yaml.
+func openAPIResourceKey(resource string) string {
+	return nonAlphanumeric.ReplaceAllString(path.Base(resource), "_")
+}
+
+func openAPIGeneratorAuthPrefix(resource, securityScheme string) string {
+	return quarkusOpenAPIGeneratorPrefix + resource + ".auth." + securityScheme + "."
+}
+
+func functionAuthEnvName(authName, field string) string {
+	return functionAuthEnvPrefix + strings.ToUpper(nonAlphanumeric.ReplaceAllString(authName+"_"+field, "_"))
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
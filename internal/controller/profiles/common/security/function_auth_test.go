@@ -0,0 +1,104 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package security
+
+import (
+	"testing"
+
+	cncfmodel "github.com/serverlessworkflow/sdk-go/v2/model"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/apache/incubator-kie-kogito-serverless-operator/test"
+)
+
+func TestGetFunctionAuthProperties(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Spec.Flow.Functions = []cncfmodel.Function{
+		{Name: "getPets", Operation: "specs/petstore.yaml#getPets", Type: cncfmodel.FunctionTypeREST, AuthRef: "petstore_auth"},
+		{Name: "addPet", Operation: "specs/petstore.yaml#addPet", AuthRef: "petstore_auth"},
+		{Name: "getStock", Operation: "https://example.com/stock-api.json#getStock", AuthRef: "stock_token"},
+		{Name: "getQuote", Operation: "quotes.yaml#getQuote", AuthRef: "quotes_oauth"},
+		{Name: "callKnative", Operation: "knative:services.v1.serving.knative.dev/custom-function#/function", AuthRef: "petstore_auth"},
+		{Name: "unknown", Operation: "unknown.yaml#op", AuthRef: "missing"},
+	}
+	workflow.Spec.Flow.Auth = cncfmodel.Auths{
+		{Name: "petstore_auth", Scheme: cncfmodel.AuthTypeBasic, Properties: cncfmodel.AuthProperties{
+			Basic: &cncfmodel.BasicAuthProperties{Secret: "petstore-secret", Username: "admin", Password: "$SECRET.password"},
+		}},
+		{Name: "stock_token", Scheme: cncfmodel.AuthTypeBearer, Properties: cncfmodel.AuthProperties{
+			Bearer: &cncfmodel.BearerAuthProperties{Secret: "stock-secret", Token: "${ $SECRET.token }"},
+		}},
+		{Name: "quotes_oauth", Scheme: cncfmodel.AuthTypeOAuth2, Properties: cncfmodel.AuthProperties{
+			OAuth2: &cncfmodel.OAuth2AuthProperties{
+				Secret:       "quotes-secret",
+				Authority:    "https://keycloak.example.com/realms/quotes",
+				GrantType:    cncfmodel.GrantTypeClientCredentials,
+				ClientID:     "quotes",
+				ClientSecret: "$SECRET.client-secret",
+				Scopes:       []string{"read", "write"},
+			},
+		}},
+	}
+
+	assert.True(t, UsesFunctionOIDCClient(workflow))
+	props := GetFunctionAuthProperties(workflow)
+	assert.Equal(t, 8, props.Len())
+	assert.Equal(t, "admin", props.GetString("quarkus.openapi-generator.petstore_yaml.auth.petstore_auth.username", ""))
+	assert.Equal(t, "${SONATAFLOW_AUTH_PETSTORE_AUTH_PASSWORD}", props.GetString("quarkus.openapi-generator.petstore_yaml.auth.petstore_auth.password", ""))
+	assert.Equal(t, "${SONATAFLOW_AUTH_STOCK_TOKEN_TOKEN}", props.GetString("quarkus.openapi-generator.stock_api_json.auth.stock_token.bearer-token", ""))
+	assert.Equal(t, "https://keycloak.example.com/realms/quotes", props.GetString("quarkus.oidc-client.quotes_oauth.auth-server-url", ""))
+	assert.Equal(t, "quotes", props.GetString("quarkus.oidc-client.quotes_oauth.client-id", ""))
+	assert.Equal(t, "${SONATAFLOW_AUTH_QUOTES_OAUTH_CLIENTSECRET}", props.GetString("quarkus.oidc-client.quotes_oauth.credentials.secret", ""))
+	assert.Equal(t, "client", props.GetString("quarkus.oidc-client.quotes_oauth.grant.type", ""))
+	assert.Equal(t, "read,write", props.GetString("quarkus.oidc-client.quotes_oauth.scopes", ""))
+
+	env := ConfigureFunctionAuthEnv(workflow)
+	assert.Equal(t, []corev1.EnvVar{
+		newSecretEnv("SONATAFLOW_AUTH_PETSTORE_AUTH_PASSWORD", "petstore-secret", "password"),
+		newSecretEnv("SONATAFLOW_AUTH_QUOTES_OAUTH_CLIENTSECRET", "quotes-secret", "client-secret"),
+		newSecretEnv("SONATAFLOW_AUTH_STOCK_TOKEN_TOKEN", "stock-secret", "token"),
+	}, env)
+}
+
+func TestGetFunctionAuthProperties_UnsupportedGrant(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Spec.Flow.Functions = []cncfmodel.Function{{Name: "getQuote", Operation: "quotes.yaml#getQuote", AuthRef: "quotes_oauth"}}
+	workflow.Spec.Flow.Auth = cncfmodel.Auths{
+		{Name: "quotes_oauth", Scheme: cncfmodel.AuthTypeOAuth2, Properties: cncfmodel.AuthProperties{
+			OAuth2: &cncfmodel.OAuth2AuthProperties{GrantType: cncfmodel.GrantTypePassword, ClientID: "quotes"},
+		}},
+	}
+	assert.False(t, UsesFunctionOIDCClient(workflow))
+	assert.Equal(t, 0, GetFunctionAuthProperties(workflow).Len())
+	assert.Empty(t, ConfigureFunctionAuthEnv(workflow))
+}
+
+func newSecretEnv(name, secret, key string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secret},
+				Key:                  key,
+			},
+		},
+	}
+}
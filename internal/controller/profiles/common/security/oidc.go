@@ -0,0 +1,147 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package security
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/magiconair/properties"
+	corev1 "k8s.io/api/core/v1"
+
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/cfg"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles"
+)
+
+const (
+	QuarkusOIDCTenantEnabled   = "quarkus.oidc.tenant-enabled"
+	QuarkusOIDCAuthServerURL   = "quarkus.oidc.auth-server-url"
+	QuarkusOIDCClientID        = "quarkus.oidc.client-id"
+	QuarkusOIDCApplicationType = "quarkus.oidc.application-type"
+	// QuarkusOIDCCredentialsSecretEnv is the environment variable holding the quarkus.oidc.credentials.secret property.
+	QuarkusOIDCCredentialsSecretEnv = "QUARKUS_OIDC_CREDENTIALS_SECRET"
+
+	quarkusHTTPAuthPermissionPrefix = "quarkus.http.auth.permission."
+	quarkusHTTPAuthPolicyPrefix     = "quarkus.http.auth.policy."
+	oidcServiceApplicationType      = "service"
+	permitPolicy                    = "permit"
+	authenticatedPolicy             = "authenticated"
+	healthPermission                = "sonataflow-health"
+	endpointsPermission             = "sonataflow-endpoints"
+	pathPermissionPrefix            = "sonataflow-path-"
+)
+
+// healthPaths are never secured, so that the probes keep working.
+var healthPaths = []string{"/q/health", "/q/health/*"}
+
+// RetrieveOIDCConfiguration returns the OIDC configuration of the workflow, defaulting to the platform one. Nil if there's none.
+func RetrieveOIDCConfiguration(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) *operatorapi.OIDCSpec {
+	if workflow.Spec.Security != nil {
+		return workflow.Spec.Security.GetOIDC()
+	}
+	if platform == nil {
+		return nil
+	}
+	return platform.Spec.Security.GetOIDC()
+}
+
+// UsesOIDC returns true if the workflow endpoints are secured with OIDC.
+func UsesOIDC(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) bool {
+	return !profiles.IsDevProfile(workflow) && RetrieveOIDCConfiguration(workflow, platform) != nil
+}
+
+// GetOIDCExtensions returns the Quarkus extensions required to secure the endpoints with OIDC.
+func GetOIDCExtensions() []cfg.GAV {
+	return cfg.GetCfg().OIDCExtensions
+}
+
+// GetOIDCWorkflowProperties returns the set of application properties securing the workflow endpoints with OIDC.
+// When no path is configured, the workflow REST endpoints require an authenticated request.
+// Never nil.
+func GetOIDCWorkflowProperties(workflow *operatorapi.SonataFlow, platform *operatorapi.SonataFlowPlatform) *properties.Properties {
+	if !UsesOIDC(workflow, platform) {
+		return properties.NewProperties()
+	}
+	oidc := RetrieveOIDCConfiguration(workflow, platform)
+	if len(oidc.Paths) == 0 {
+		return GetOIDCServiceProperties(oidc, "/"+workflow.Name, "/"+workflow.Name+"/*")
+	}
+	props := generateOIDCProperties(oidc)
+	for i, path := range oidc.Paths {
+		name := fmt.Sprintf("%s%d", pathPermissionPrefix, i)
+		policy := authenticatedPolicy
+		if path.Public {
+			policy = permitPolicy
+		} else if len(path.Roles) > 0 {
+			policy = name
+			props.Set(quarkusHTTPAuthPolicyPrefix+name+".roles-allowed", strings.Join(path.Roles, ","))
+		}
+		setPermission(props, name, []string{path.Path}, path.Methods, policy)
+	}
+	props.Sort()
+	return props
+}
+
+// GetOIDCServiceProperties returns the set of application properties securing the given paths with OIDC. Any authenticated
+// request is allowed on these paths.
+// Never nil.
+func GetOIDCServiceProperties(oidc *operatorapi.OIDCSpec, securedPaths ...string) *properties.Properties {
+	if oidc == nil {
+		return properties.NewProperties()
+	}
+	props := generateOIDCProperties(oidc)
+	setPermission(props, endpointsPermission, securedPaths, nil, authenticatedPolicy)
+	props.Sort()
+	return props
+}
+
+func generateOIDCProperties(oidc *operatorapi.OIDCSpec) *properties.Properties {
+	props := properties.NewProperties()
+	props.Set(QuarkusOIDCTenantEnabled, "true")
+	props.Set(QuarkusOIDCAuthServerURL, oidc.AuthServerURL)
+	props.Set(QuarkusOIDCClientID, oidc.ClientID)
+	props.Set(QuarkusOIDCApplicationType, oidcServiceApplicationType)
+	setPermission(props, healthPermission, healthPaths, nil, permitPolicy)
+	return props
+}
+
+func setPermission(props *properties.Properties, name string, paths []string, methods []string, policy string) {
+	props.Set(quarkusHTTPAuthPermissionPrefix+name+".paths", strings.Join(paths, ","))
+	props.Set(quarkusHTTPAuthPermissionPrefix+name+".policy", policy)
+	if len(methods) > 0 {
+		props.Set(quarkusHTTPAuthPermissionPrefix+name+".methods", strings.Join(methods, ","))
+	}
+}
+
+// ConfigureOIDCEnv returns the env variables holding the OIDC client secret, if any.
+func ConfigureOIDCEnv(oidc *operatorapi.OIDCSpec) []corev1.EnvVar {
+	if oidc == nil || oidc.ClientSecretRef == nil {
+		return nil
+	}
+	return []corev1.EnvVar{
+		{
+			Name: QuarkusOIDCCredentialsSecretEnv,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: oidc.ClientSecretRef.DeepCopy(),
+			},
+		},
+	}
+}
@@ -0,0 +1,96 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package security
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/test"
+)
+
+func newOIDCSpec(paths ...operatorapi.OIDCPathSpec) *operatorapi.OIDCSpec {
+	return &operatorapi.OIDCSpec{
+		AuthServerURL: "https://keycloak.example.com/realms/sonataflow",
+		ClientID:      "sonataflow",
+		ClientSecretRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "oidc-secret"},
+			Key:                  "client-secret",
+		},
+		Paths: paths,
+	}
+}
+
+func TestGetOIDCWorkflowProperties_DefaultsToPlatform(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	platform := test.GetBasePlatform()
+	assert.Equal(t, 0, GetOIDCWorkflowProperties(workflow, platform).Len())
+
+	platform.Spec.Security = &operatorapi.SecuritySpec{OIDC: newOIDCSpec()}
+	props := GetOIDCWorkflowProperties(workflow, platform)
+	assert.Equal(t, "true", props.GetString(QuarkusOIDCTenantEnabled, ""))
+	assert.Equal(t, "https://keycloak.example.com/realms/sonataflow", props.GetString(QuarkusOIDCAuthServerURL, ""))
+	assert.Equal(t, "sonataflow", props.GetString(QuarkusOIDCClientID, ""))
+	assert.Equal(t, "service", props.GetString(QuarkusOIDCApplicationType, ""))
+	assert.Equal(t, "/q/health,/q/health/*", props.GetString("quarkus.http.auth.permission.sonataflow-health.paths", ""))
+	assert.Equal(t, "permit", props.GetString("quarkus.http.auth.permission.sonataflow-health.policy", ""))
+	assert.Equal(t, "/"+workflow.Name+",/"+workflow.Name+"/*", props.GetString("quarkus.http.auth.permission.sonataflow-endpoints.paths", ""))
+	assert.Equal(t, "authenticated", props.GetString("quarkus.http.auth.permission.sonataflow-endpoints.policy", ""))
+
+	workflow.Spec.Security = &operatorapi.SecuritySpec{}
+	assert.Equal(t, 0, GetOIDCWorkflowProperties(workflow, platform).Len())
+}
+
+func TestGetOIDCWorkflowProperties_Paths(t *testing.T) {
+	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow.Spec.Security = &operatorapi.SecuritySpec{OIDC: newOIDCSpec(
+		operatorapi.OIDCPathSpec{Path: "/greeting", Methods: []string{"POST"}, Roles: []string{"admin", "user"}},
+		operatorapi.OIDCPathSpec{Path: "/greeting/*"},
+		operatorapi.OIDCPathSpec{Path: "/q/openapi", Public: true},
+	)}
+	props := GetOIDCWorkflowProperties(workflow, nil)
+	assert.Equal(t, "/greeting", props.GetString("quarkus.http.auth.permission.sonataflow-path-0.paths", ""))
+	assert.Equal(t, "POST", props.GetString("quarkus.http.auth.permission.sonataflow-path-0.methods", ""))
+	assert.Equal(t, "sonataflow-path-0", props.GetString("quarkus.http.auth.permission.sonataflow-path-0.policy", ""))
+	assert.Equal(t, "admin,user", props.GetString("quarkus.http.auth.policy.sonataflow-path-0.roles-allowed", ""))
+	assert.Equal(t, "authenticated", props.GetString("quarkus.http.auth.permission.sonataflow-path-1.policy", ""))
+	assert.Equal(t, "permit", props.GetString("quarkus.http.auth.permission.sonataflow-path-2.policy", ""))
+	_, ok := props.Get("quarkus.http.auth.permission.sonataflow-endpoints.paths")
+	assert.False(t, ok)
+}
+
+func TestGetOIDCWorkflowProperties_DevProfile(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithDevProfile(t.Name())
+	workflow.Spec.Security = &operatorapi.SecuritySpec{OIDC: newOIDCSpec()}
+	assert.False(t, UsesOIDC(workflow, nil))
+	assert.Equal(t, 0, GetOIDCWorkflowProperties(workflow, nil).Len())
+}
+
+func TestConfigureOIDCEnv(t *testing.T) {
+	assert.Empty(t, ConfigureOIDCEnv(nil))
+	env := ConfigureOIDCEnv(newOIDCSpec())
+	assert.Len(t, env, 1)
+	assert.Equal(t, QuarkusOIDCCredentialsSecretEnv, env[0].Name)
+	assert.Equal(t, "oidc-secret", env[0].ValueFrom.SecretKeyRef.Name)
+	assert.Equal(t, "client-secret", env[0].ValueFrom.SecretKeyRef.Key)
+}
@@ -0,0 +1,187 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dev
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api/metadata"
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/constants"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/log"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/workflowproj"
+)
+
+const (
+	// httpRequestsMetric is the Micrometer counter of the HTTP requests served by the Quarkus application
+	httpRequestsMetric = "http_server_requests_seconds_count"
+	quarkusMetricsPath = "/q/metrics"
+	// quarkusToolsURIPrefix matches the requests to the Quarkus endpoints, like the health probes, that aren't a developer activity
+	quarkusToolsURIPrefix = `uri="/q/`
+)
+
+// requestsCounter returns the number of HTTP requests served by the workflow pods.
+type requestsCounter func(ctx context.Context, workflow *operatorapi.SonataFlow) (int64, error)
+
+// proxyClientset is shared by the requests counters, created once since a profile reconciler is built on every reconciliation.
+var (
+	proxyClientset     kubernetes.Interface
+	proxyClientsetLock sync.Mutex
+)
+
+func getProxyClientset(cfg *rest.Config) (kubernetes.Interface, error) {
+	proxyClientsetLock.Lock()
+	defer proxyClientsetLock.Unlock()
+	if proxyClientset == nil {
+		clientset, err := kubernetes.NewForConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		proxyClientset = clientset
+	}
+	return proxyClientset, nil
+}
+
+// newPodProxyRequestsCounter reads the requests served by the running workflow pods from their metrics endpoint,
+// through the API server pods proxy, so the operator doesn't need to reach the pods network.
+func newPodProxyRequestsCounter(c client.Client, cfg *rest.Config) requestsCounter {
+	clientset, err := getProxyClientset(cfg)
+	if err != nil {
+		return func(ctx context.Context, workflow *operatorapi.SonataFlow) (int64, error) {
+			return 0, err
+		}
+	}
+	return func(ctx context.Context, workflow *operatorapi.SonataFlow) (int64, error) {
+		podList := &corev1.PodList{}
+		if err := c.List(ctx, podList, client.InNamespace(workflow.Namespace), client.MatchingLabels(workflowproj.GetSelectorLabels(workflow))); err != nil {
+			return 0, err
+		}
+		var requests int64
+		for _, pod := range podList.Items {
+			if pod.Status.Phase != corev1.PodRunning {
+				continue
+			}
+			metrics, err := clientset.CoreV1().Pods(pod.Namespace).
+				ProxyGet("http", pod.Name, strconv.Itoa(constants.DefaultHTTPWorkflowPortInt), quarkusMetricsPath, nil).DoRaw(ctx)
+			if err != nil {
+				return 0, err
+			}
+			requests += countWorkflowRequests(string(metrics))
+		}
+		return requests, nil
+	}
+}
+
+// countWorkflowRequests sums the HTTP requests in the given Prometheus metrics, skipping the requests to the Quarkus endpoints.
+func countWorkflowRequests(metrics string) int64 {
+	var requests float64
+	for _, line := range strings.Split(metrics, "\n") {
+		if !strings.HasPrefix(line, httpRequestsMetric+"{") || strings.Contains(line, quarkusToolsURIPrefix) {
+			continue
+		}
+		fields := strings.Fields(line[strings.LastIndex(line, "}")+1:])
+		if len(fields) == 0 {
+			continue
+		}
+		if value, err := strconv.ParseFloat(fields[0], 64); err == nil {
+			requests += value
+		}
+	}
+	return int64(requests)
+}
+
+// idleTimeout returns the dev mode idle timeout, zero if the workflows are never scaled down.
+func idleTimeout(pl *operatorapi.SonataFlowPlatform) time.Duration {
+	if pl == nil || pl.Spec.DevMode.IdleTimeout == nil {
+		return 0
+	}
+	return pl.Spec.DevMode.IdleTimeout.Duration
+}
+
+// recordActivity sets the workflow last activity, the observed requests are the ones served since the pods started.
+func recordActivity(workflow *operatorapi.SonataFlow, requests int64) {
+	if workflow.Status.DevMode == nil {
+		workflow.Status.DevMode = &operatorapi.DevModeStatus{}
+	}
+	now := metav1.Now()
+	workflow.Status.DevMode.ObservedRequests = requests
+	workflow.Status.DevMode.LastActivityTime = &now
+}
+
+// wakeUpIfRequested scales up a workflow scaled down while idle, if its spec has changed, the wake-up annotation is set,
+// or the idle timeout was removed from the platform. Returns true if the workflow was woken up.
+func (e *ensureRunningWorkflowState) wakeUpIfRequested(ctx context.Context, workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform) (bool, error) {
+	if !workflow.Status.IsScaledDownWhileIdle() {
+		return false, nil
+	}
+	_, wakeUp := workflow.Annotations[metadata.WakeUp]
+	if !wakeUp && workflow.Generation == workflow.Status.ObservedGeneration && idleTimeout(pl) > 0 {
+		return false, nil
+	}
+	if wakeUp {
+		patch := client.MergeFrom(workflow.DeepCopy())
+		delete(workflow.Annotations, metadata.WakeUp)
+		if err := e.C.Patch(ctx, workflow, patch); err != nil {
+			return false, err
+		}
+	}
+	klog.V(log.I).InfoS("Waking up the workflow scaled down while idle", "Workflow", workflow.Name)
+	recordActivity(workflow, 0)
+	workflow.Status.Manager().MarkUnknown(api.RunningConditionType, "", "")
+	return true, nil
+}
+
+// scaleDownIfIdle tracks the workflow activity and marks the workflow to be scaled down once it has been idle for longer than
+// the given timeout. If the served requests can't be read, the workflow is considered active. Returns true if the workflow was marked.
+func (e *ensureRunningWorkflowState) scaleDownIfIdle(ctx context.Context, workflow *operatorapi.SonataFlow, timeout time.Duration) (bool, error) {
+	requests, err := e.countRequests(ctx, workflow)
+	if err != nil {
+		klog.V(log.I).InfoS("Unable to read the requests served by the workflow, skipping the idle check", "Workflow", workflow.Name, "Error", err)
+		e.Recorder.Eventf(workflow, corev1.EventTypeWarning, "IdleCheckSkipped",
+			"Unable to read the requests served by the workflow from its metrics, it won't be scaled down while idle: %v", err)
+		return false, nil
+	}
+	devMode := workflow.Status.DevMode
+	if devMode == nil || devMode.LastActivityTime == nil || devMode.ObservedRequests != requests || workflow.Generation != workflow.Status.ObservedGeneration {
+		recordActivity(workflow, requests)
+		_, err = e.PerformStatusUpdate(ctx, workflow)
+		return false, err
+	}
+	if time.Since(devMode.LastActivityTime.Time) < timeout {
+		return false, nil
+	}
+	workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.ScaledDownWhileIdleReason,
+		"No HTTP requests served since %s, add the %s annotation to scale the workflow up again", devMode.LastActivityTime.Format(time.RFC3339), metadata.WakeUp)
+	e.Recorder.Eventf(workflow, corev1.EventTypeNormal, api.ScaledDownWhileIdleReason, "Workflow %s scaled down after being idle for %s", workflow.Name, timeout)
+	_, err = e.PerformStatusUpdate(ctx, workflow)
+	return true, err
+}
@@ -0,0 +1,122 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package dev
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	clientruntime "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api/metadata"
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/test"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
+)
+
+func Test_countWorkflowRequests(t *testing.T) {
+	metrics := `# HELP http_server_requests_seconds
+# TYPE http_server_requests_seconds summary
+http_server_requests_seconds_count{method="POST",outcome="SUCCESS",status="201",uri="/greeting"} 3.0
+http_server_requests_seconds_sum{method="POST",outcome="SUCCESS",status="201",uri="/greeting"} 0.25
+http_server_requests_seconds_count{method="GET",outcome="SUCCESS",status="200",uri="/greeting/{id}"} 2.0
+http_server_requests_seconds_count{method="GET",outcome="SUCCESS",status="200",uri="/q/health/ready"} 42.0
+`
+	assert.Equal(t, int64(5), countWorkflowRequests(metrics))
+	assert.Equal(t, int64(0), countWorkflowRequests(""))
+}
+
+func Test_devProfileScalesDownWhileIdle(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithDevProfile(t.Name())
+	workflowID := clientruntime.ObjectKeyFromObject(workflow)
+	platform := test.GetBasePlatformInReadyPhase(workflow.Namespace)
+	platform.Spec.DevMode.IdleTimeout = &metav1.Duration{Duration: time.Nanosecond}
+	client := test.NewSonataFlowClientBuilder().WithRuntimeObjects(workflow, platform).WithStatusSubresource(workflow, platform).Build()
+	utils.SetDiscoveryClient(test.CreateFakeKnativeAndMonitoringDiscoveryClient())
+
+	support := &common.StateSupport{C: client, Cfg: &rest.Config{}, Recorder: test.NewFakeRecorder()}
+	requests := int64(0)
+	state := &ensureRunningWorkflowState{StateSupport: support, ensurers: newObjectEnsurers(support),
+		countRequests: func(ctx context.Context, workflow *operatorapi.SonataFlow) (int64, error) { return requests, nil }}
+	reconcile := func() {
+		workflow = test.MustGetWorkflow(t, client, workflowID)
+		assert.True(t, state.CanReconcile(workflow))
+		_, _, err := state.Do(context.TODO(), workflow)
+		assert.NoError(t, err)
+	}
+
+	// create the objects and make the deployment available
+	reconcile()
+	deployment := test.MustGetDeployment(t, client, workflow)
+	deployment.Status.Conditions = []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue}}
+	assert.NoError(t, client.Status().Update(context.TODO(), deployment))
+	workflow = test.MustGetWorkflow(t, client, workflowID)
+	workflow.Status.Manager().MarkTrue(api.RunningConditionType)
+	assert.NoError(t, client.Status().Update(context.TODO(), workflow))
+
+	// the first check records the activity, new requests record it again
+	reconcile()
+	assert.NotNil(t, workflow.Status.DevMode.LastActivityTime)
+	requests = 3
+	reconcile()
+	assert.Equal(t, int64(3), workflow.Status.DevMode.ObservedRequests)
+	assert.True(t, workflow.Status.IsReady())
+
+	// no requests since the last check
+	reconcile()
+	assert.True(t, workflow.Status.IsScaledDownWhileIdle())
+	reconcile()
+	assert.Equal(t, int32(0), *test.MustGetDeployment(t, client, workflow).Spec.Replicas)
+
+	// the annotation wakes the workflow up and is removed
+	workflow = test.MustGetWorkflow(t, client, workflowID)
+	workflow.Annotations[metadata.WakeUp] = "true"
+	assert.NoError(t, client.Update(context.TODO(), workflow))
+	reconcile()
+	assert.False(t, workflow.Status.IsScaledDownWhileIdle())
+	assert.NotContains(t, test.MustGetWorkflow(t, client, workflowID).Annotations, metadata.WakeUp)
+	assert.NotEqual(t, int32(0), *test.MustGetDeployment(t, client, workflow).Spec.Replicas)
+}
+
+func Test_scaleDownIfIdleWithoutMetrics(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithDevProfile(t.Name())
+	recorder := record.NewFakeRecorder(1)
+	support := &common.StateSupport{C: test.NewSonataFlowClientBuilder().Build(), Cfg: &rest.Config{}, Recorder: recorder}
+	state := &ensureRunningWorkflowState{StateSupport: support,
+		countRequests: func(ctx context.Context, workflow *operatorapi.SonataFlow) (int64, error) {
+			return 0, errors.New("forbidden")
+		}}
+
+	// the workflow is considered active and the failure reported
+	scaledDown, err := state.scaleDownIfIdle(context.TODO(), workflow, time.Nanosecond)
+	assert.NoError(t, err)
+	assert.False(t, scaledDown)
+	assert.Contains(t, <-recorder.Events, "IdleCheckSkipped")
+}
@@ -20,18 +20,22 @@
 package dev
 
 import (
+	"fmt"
 	"path"
+	"strings"
 
-	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/cfg"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/workflowdef"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
 	kubeutil "github.com/apache/incubator-kie-kogito-serverless-operator/utils/kubernetes"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/workflowproj"
 )
@@ -50,25 +54,77 @@ func serviceCreator(workflow *operatorapi.SonataFlow) (client.Object, error) {
 	return service, nil
 }
 
-func deploymentCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) (client.Object, error) {
+// debugServiceCreator creates a NodePort Service exposing the JVM debug port of the workflow running in dev mode.
+// Returns nil if the remote debugging isn't enabled in the platform.
+func debugServiceCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) (client.Object, error) {
+	if !isDebugEnabled(plf) {
+		return nil, nil
+	}
+	lbl := workflowproj.GetMergedLabels(workflow)
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      debugServiceName(workflow),
+			Namespace: workflow.Namespace,
+			Labels:    lbl,
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeNodePort,
+			Selector: workflowproj.GetMergedLabels(workflow),
+			Ports: []corev1.ServicePort{{
+				Name:       debugPortName,
+				Protocol:   corev1.ProtocolTCP,
+				Port:       plf.Spec.DevMode.Debug.GetPort(),
+				TargetPort: intstr.FromString(debugPortName),
+			}},
+		},
+	}
+	return service, nil
+}
 
-	obj, err := common.DeploymentCreator(workflow, plf)
+// deploymentCreator creates the dev Deployment, the dev health probes thresholds are merged by common.DeploymentCreator.
+// If the remote debugging is enabled in the platform, the JVM debug port is opened in the workflow container.
+// A workflow scaled down while idle has no replicas until it's woken up.
+func deploymentCreator(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) (client.Object, error) {
+	object, err := common.DeploymentCreator(workflow, plf)
 	if err != nil {
 		return nil, err
 	}
-	deployment := obj.(*appsv1.Deployment)
-	_, idx := kubeutil.GetContainerByName(operatorapi.DefaultContainerName, &deployment.Spec.Template.Spec)
-	healthThreshold := cfg.GetCfg().HealthFailureThresholdDevMode
-	if workflow.Spec.PodTemplate.Container.StartupProbe == nil {
-		deployment.Spec.Template.Spec.Containers[idx].StartupProbe.FailureThreshold = healthThreshold
+	if workflow.Status.IsScaledDownWhileIdle() {
+		object.(*appsv1.Deployment).Spec.Replicas = utils.Pint(0)
 	}
-	if workflow.Spec.PodTemplate.Container.LivenessProbe == nil {
-		deployment.Spec.Template.Spec.Containers[idx].LivenessProbe.FailureThreshold = healthThreshold
+	if isDebugEnabled(plf) {
+		deployment := object.(*appsv1.Deployment)
+		if _, idx := kubeutil.GetContainerByName(operatorapi.DefaultContainerName, &deployment.Spec.Template.Spec); idx >= 0 {
+			addDebugConfiguration(&deployment.Spec.Template.Spec.Containers[idx], plf.Spec.DevMode.Debug)
+		}
 	}
-	if workflow.Spec.PodTemplate.Container.ReadinessProbe == nil {
-		deployment.Spec.Template.Spec.Containers[idx].ReadinessProbe.FailureThreshold = healthThreshold
+	return object, nil
+}
+
+// addDebugConfiguration exposes the debug port in the container and instructs Quarkus dev mode to open it on every interface.
+func addDebugConfiguration(container *corev1.Container, debug *operatorapi.DevModeDebugSpec) {
+	port := debug.GetPort()
+	container.Ports = append(container.Ports, corev1.ContainerPort{
+		Name:          debugPortName,
+		ContainerPort: port,
+		Protocol:      corev1.ProtocolTCP,
+	})
+	debugArgs := fmt.Sprintf("-Ddebug=%d -DdebugHost=0.0.0.0 -Dsuspend=%t", port, debug.Suspend)
+	for i := range container.Env {
+		if container.Env[i].Name == mavenArgsAppendEnv {
+			container.Env[i].Value = strings.TrimSpace(container.Env[i].Value + " " + debugArgs)
+			return
+		}
 	}
-	return deployment, nil
+	container.Env = append(container.Env, corev1.EnvVar{Name: mavenArgsAppendEnv, Value: debugArgs})
+}
+
+func isDebugEnabled(plf *operatorapi.SonataFlowPlatform) bool {
+	return plf != nil && plf.Spec.DevMode.IsDebugEnabled()
+}
+
+func debugServiceName(workflow *operatorapi.SonataFlow) string {
+	return workflow.Name + debugServiceSuffix
 }
 
 // workflowDefConfigMapCreator creates a new ConfigMap that holds the definition of a workflow specification.
@@ -80,6 +136,27 @@ func workflowDefConfigMapCreator(workflow *operatorapi.SonataFlow) (client.Objec
 	return configMap, nil
 }
 
+// debugServiceMutateVisitor guarantees the state of the debug Service, the allocated NodePort is kept by the cluster.
+func debugServiceMutateVisitor(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) common.MutateVisitor {
+	return func(object client.Object) controllerutil.MutateFn {
+		return func() error {
+			if kubeutil.IsObjectNew(object) {
+				return nil
+			}
+			original, err := debugServiceCreator(workflow, plf)
+			if err != nil || original == nil {
+				return err
+			}
+			service := object.(*corev1.Service)
+			service.Spec.Type = corev1.ServiceTypeNodePort
+			service.Spec.Ports = original.(*corev1.Service).Spec.Ports
+			service.Spec.Selector = original.(*corev1.Service).Spec.Selector
+			service.Labels = original.GetLabels()
+			return nil
+		}
+	}
+}
+
 // deploymentMutateVisitor guarantees the state of the default Deployment object
 func deploymentMutateVisitor(workflow *operatorapi.SonataFlow, plf *operatorapi.SonataFlowPlatform) common.MutateVisitor {
 	return func(object client.Object) controllerutil.MutateFn {
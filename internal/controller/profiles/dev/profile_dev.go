@@ -62,9 +62,9 @@ func NewProfileReconciler(client client.Client, cfg *rest.Config, recorder recor
 	}
 
 	stateMachine := common.NewReconciliationStateMachine(
-		&ensureRunningWorkflowState{StateSupport: support, ensurers: ensurers},
+		&ensureRunningWorkflowState{StateSupport: support, ensurers: ensurers, countRequests: newPodProxyRequestsCounter(client, cfg)},
 		&followWorkflowDeploymentState{StateSupport: support, enrichers: enrichers},
-		&recoverFromFailureState{StateSupport: support})
+		common.NewRecoverFromFailureState(support, recoveryPolicy, resetWorkflow))
 
 	profile := &developmentProfile{
 		Reconciler: common.NewReconciler(support, stateMachine),
@@ -78,6 +78,7 @@ func newObjectEnsurers(support *common.StateSupport) *objectEnsurers {
 	return &objectEnsurers{
 		deployment:            common.NewObjectEnsurerWithPlatform(support.C, deploymentCreator),
 		service:               common.NewObjectEnsurer(support.C, serviceCreator),
+		debugService:          common.NewObjectEnsurerWithPlatform(support.C, debugServiceCreator),
 		serviceMonitor:        common.NewObjectEnsurer(support.C, common.ServiceMonitorCreator),
 		network:               common.NewNoopObjectEnsurer(),
 		definitionConfigMap:   common.NewObjectEnsurer(support.C, workflowDefConfigMapCreator),
@@ -90,6 +91,7 @@ func newObjectEnsurersOpenShift(support *common.StateSupport) *objectEnsurers {
 	return &objectEnsurers{
 		deployment:            common.NewObjectEnsurerWithPlatform(support.C, deploymentCreator),
 		service:               common.NewObjectEnsurer(support.C, serviceCreator),
+		debugService:          common.NewObjectEnsurerWithPlatform(support.C, debugServiceCreator),
 		serviceMonitor:        common.NewObjectEnsurer(support.C, common.ServiceMonitorCreator),
 		network:               common.NewObjectEnsurer(support.C, common.OpenShiftRouteCreator),
 		definitionConfigMap:   common.NewObjectEnsurer(support.C, workflowDefConfigMapCreator),
@@ -113,6 +115,7 @@ func newStatusEnrichersOpenShift(support *common.StateSupport) *statusEnrichers
 type objectEnsurers struct {
 	deployment            common.ObjectEnsurerWithPlatform
 	service               common.ObjectEnsurer
+	debugService          common.ObjectEnsurerWithPlatform
 	serviceMonitor        common.ObjectEnsurer
 	network               common.ObjectEnsurer
 	definitionConfigMap   common.ObjectEnsurer
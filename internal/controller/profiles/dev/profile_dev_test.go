@@ -28,6 +28,7 @@ import (
 	"k8s.io/client-go/rest"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -55,7 +56,7 @@ import (
 )
 
 func Test_OverrideStartupProbe(t *testing.T) {
-	workflow := test.GetBaseSonataFlow(t.Name())
+	workflow := test.GetBaseSonataFlowWithDevProfile(t.Name())
 
 	client := test.NewSonataFlowClientBuilder().WithRuntimeObjects(workflow).WithStatusSubresource(workflow).Build()
 
@@ -243,6 +244,50 @@ func Test_devProfileWithImageSnapshotOverrideWithPlatform(t *testing.T) {
 	assert.Equal(t, "quay.io/customgroup/custom-swf-builder-nightly:42.43.7", deployment.Spec.Template.Spec.Containers[0].Image)
 }
 
+func Test_devProfileWithDebugEnabled(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithDevProfile(t.Name())
+	platform := test.GetBasePlatformInReadyPhase(workflow.Namespace)
+	platform.Spec.DevMode.Debug = &operatorapi.DevModeDebugSpec{Port: utils.Pint(5006), Suspend: true}
+
+	client := test.NewSonataFlowClientBuilder().WithRuntimeObjects(workflow, platform).WithStatusSubresource(workflow, platform).Build()
+	utils.SetDiscoveryClient(test.CreateFakeKnativeAndMonitoringDiscoveryClient())
+
+	devReconciler := NewProfileReconciler(client, &rest.Config{}, test.NewFakeRecorder())
+
+	result, err := devReconciler.Reconcile(context.TODO(), workflow)
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+
+	deployment := test.MustGetDeployment(t, client, workflow)
+	container, _ := kubeutil.GetContainerByName(operatorapi.DefaultContainerName, &deployment.Spec.Template.Spec)
+	assert.Contains(t, container.Ports, corev1.ContainerPort{Name: debugPortName, ContainerPort: 5006, Protocol: corev1.ProtocolTCP})
+	assert.Contains(t, container.Env, corev1.EnvVar{Name: mavenArgsAppendEnv, Value: "-Ddebug=5006 -DdebugHost=0.0.0.0 -Dsuspend=true"})
+
+	debugService := &corev1.Service{}
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name + debugServiceSuffix}, debugService))
+	assert.Equal(t, corev1.ServiceTypeNodePort, debugService.Spec.Type)
+	assert.Len(t, debugService.Spec.Ports, 1)
+	assert.Equal(t, int32(5006), debugService.Spec.Ports[0].Port)
+	assert.Equal(t, intstr.FromString(debugPortName), debugService.Spec.Ports[0].TargetPort)
+
+	// disabling the debug removes the Service and closes the port
+	platform.Spec.DevMode.Debug = nil
+	assert.NoError(t, client.Update(context.TODO(), platform))
+	workflow = test.MustGetWorkflow(t, client, clientruntime.ObjectKeyFromObject(workflow))
+	workflow.Status.Manager().MarkTrue(api.RunningConditionType)
+	result, err = devReconciler.Reconcile(context.TODO(), workflow)
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+
+	err = client.Get(context.TODO(), types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name + debugServiceSuffix}, debugService)
+	assert.True(t, errors.IsNotFound(err))
+	deployment = test.MustGetDeployment(t, client, workflow)
+	container, _ = kubeutil.GetContainerByName(operatorapi.DefaultContainerName, &deployment.Spec.Template.Spec)
+	for _, port := range container.Ports {
+		assert.NotEqual(t, debugPortName, port.Name)
+	}
+}
+
 func Test_devProfileWithWPlatformWithoutDevBaseImageAndWithBaseImage(t *testing.T) {
 	workflow := test.GetBaseSonataFlowWithDevProfile(t.Name())
 
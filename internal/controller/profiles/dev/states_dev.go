@@ -21,13 +21,10 @@ package dev
 
 import (
 	"context"
-	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -49,15 +46,23 @@ const (
 	// quarkusDevConfigMountPath mount path for application properties file in the Workflow Quarkus Application
 	// See: https://quarkus.io/guides/config-reference#application-properties-file
 	quarkusDevConfigMountPath = "/home/kogito/serverless-workflow-project/src/main/resources"
+	// mavenArgsAppendEnv holds the extra arguments of the Maven command running Quarkus dev mode in the dev image
+	mavenArgsAppendEnv = "MAVEN_ARGS_APPEND"
+	debugPortName      = "debug"
+	debugServiceSuffix = "-debug"
+	devUIPath          = "/q/dev-ui"
+	swaggerUIPath      = "/q/swagger-ui"
 )
 
 type ensureRunningWorkflowState struct {
 	*common.StateSupport
-	ensurers *objectEnsurers
+	ensurers      *objectEnsurers
+	countRequests requestsCounter
 }
 
 func (e *ensureRunningWorkflowState) CanReconcile(workflow *operatorapi.SonataFlow) bool {
-	return workflow.Status.IsReady() || workflow.Status.GetTopLevelCondition().IsUnknown() || workflow.Status.IsChildObjectsProblem()
+	return workflow.Status.IsReady() || workflow.Status.GetTopLevelCondition().IsUnknown() || workflow.Status.IsChildObjectsProblem() ||
+		workflow.Status.IsScaledDownWhileIdle()
 }
 
 func (e *ensureRunningWorkflowState) Do(ctx context.Context, workflow *operatorapi.SonataFlow) (ctrl.Result, []client.Object, error) {
@@ -78,6 +83,9 @@ func (e *ensureRunningWorkflowState) Do(ctx context.Context, workflow *operatora
 	if pl != nil && len(pl.Spec.DevMode.BaseImage) > 0 {
 		devBaseContainerImage = pl.Spec.DevMode.BaseImage
 	}
+	if _, err = e.wakeUpIfRequested(ctx, workflow, pl); err != nil {
+		return ctrl.Result{RequeueAfter: constants.RequeueAfterFailure}, objs, err
+	}
 	userPropsCM, _, err := e.ensurers.userPropsConfigMap.Ensure(ctx, workflow)
 	if err != nil {
 		return ctrl.Result{Requeue: false}, objs, err
@@ -112,6 +120,14 @@ func (e *ensureRunningWorkflowState) Do(ctx context.Context, workflow *operatora
 	}
 	objs = append(objs, service)
 
+	debugService, err := e.ensureDebugService(ctx, workflow, pl)
+	if err != nil {
+		return ctrl.Result{RequeueAfter: constants.RequeueAfterFailure}, objs, err
+	}
+	if debugService != nil {
+		objs = append(objs, debugService)
+	}
+
 	serviceMonitor, err := e.ensureServiceMonitor(ctx, workflow, pl)
 	if err != nil {
 		return ctrl.Result{RequeueAfter: constants.RequeueAfterFailure}, objs, err
@@ -136,6 +152,12 @@ func (e *ensureRunningWorkflowState) Do(ctx context.Context, workflow *operatora
 		return ctrl.Result{RequeueAfter: constants.RequeueAfterIsRunning}, objs, nil
 	}
 
+	// Scaled down while idle, nothing to follow until the workflow is woken up: spec changes, the wake-up annotation
+	// and the removal of the platform idle timeout trigger a new reconciliation
+	if workflow.Status.IsScaledDownWhileIdle() {
+		return ctrl.Result{}, objs, nil
+	}
+
 	// Is the deployment still available?
 	convertedDeployment := deployment.(*appsv1.Deployment)
 	if !kubeutil.IsDeploymentAvailable(convertedDeployment) {
@@ -146,11 +168,29 @@ func (e *ensureRunningWorkflowState) Do(ctx context.Context, workflow *operatora
 		if _, err = e.PerformStatusUpdate(ctx, workflow); err != nil {
 			return ctrl.Result{RequeueAfter: constants.RequeueAfterFailure}, objs, err
 		}
+	} else if timeout := idleTimeout(pl); timeout > 0 {
+		scaledDown, err := e.scaleDownIfIdle(ctx, workflow, timeout)
+		if err != nil {
+			return ctrl.Result{RequeueAfter: constants.RequeueAfterFailure}, objs, err
+		}
+		if scaledDown {
+			return ctrl.Result{Requeue: true}, objs, nil
+		}
 	}
 
 	return ctrl.Result{RequeueAfter: constants.RequeueAfterIsRunning}, objs, nil
 }
 
+// ensureDebugService exposes the JVM debug port if the remote debugging is enabled, otherwise removes the debug Service.
+func (e *ensureRunningWorkflowState) ensureDebugService(ctx context.Context, workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform) (client.Object, error) {
+	if !isDebugEnabled(pl) {
+		debugService := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: debugServiceName(workflow), Namespace: workflow.Namespace}}
+		return nil, client.IgnoreNotFound(e.C.Delete(ctx, debugService))
+	}
+	debugService, _, err := e.ensurers.debugService.Ensure(ctx, workflow, pl, debugServiceMutateVisitor(workflow, pl))
+	return debugService, err
+}
+
 func (e *ensureRunningWorkflowState) ensureServiceMonitor(ctx context.Context, workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform) (client.Object, error) {
 	if monitoring.IsMonitoringEnabled(pl) {
 		serviceMonitor, _, err := e.ensurers.serviceMonitor.Ensure(ctx, workflow)
@@ -203,81 +243,15 @@ func (f *followWorkflowDeploymentState) PostReconcile(ctx context.Context, workf
 	return nil
 }
 
-type recoverFromFailureState struct {
-	*common.StateSupport
-}
-
-func (r *recoverFromFailureState) CanReconcile(workflow *operatorapi.SonataFlow) bool {
-	return workflow.Status.GetCondition(api.RunningConditionType).IsFalse()
-}
-
-func (r *recoverFromFailureState) Do(ctx context.Context, workflow *operatorapi.SonataFlow) (ctrl.Result, []client.Object, error) {
-	// for now, a very basic attempt to recover by rolling out the deployment
-	deployment := &appsv1.Deployment{}
-	if err := r.C.Get(ctx, client.ObjectKeyFromObject(workflow), deployment); err != nil {
-		// if the deployment is not there, let's try to reset the status condition and make the reconciliation fix the objects
-		if errors.IsNotFound(err) {
-			klog.V(log.I).InfoS("Tried to recover from failed state, no deployment found, trying to reset the workflow conditions")
-			workflow.Status.RecoverFailureAttempts = 0
-			workflow.Status.Manager().MarkUnknown(api.RunningConditionType, "", "")
-			if _, updateErr := r.PerformStatusUpdate(ctx, workflow); updateErr != nil {
-				return ctrl.Result{Requeue: false}, nil, updateErr
-			}
-			return ctrl.Result{RequeueAfter: constants.RequeueAfterFailure}, nil, nil
-		}
-		return ctrl.Result{Requeue: false}, nil, err
-	}
-
-	// if the deployment is progressing we might have good news
-	if kubeutil.IsDeploymentAvailable(deployment) {
-		workflow.Status.RecoverFailureAttempts = 0
-		workflow.Status.Manager().MarkTrue(api.RunningConditionType)
-		if _, updateErr := r.PerformStatusUpdate(ctx, workflow); updateErr != nil {
-			return ctrl.Result{Requeue: false}, nil, updateErr
-		}
-		return ctrl.Result{RequeueAfter: constants.RequeueAfterFailure}, nil, nil
+// recoveryPolicy returns the dev mode recovery policy, the workflows running in dev mode are always recovered.
+func recoveryPolicy(plf *operatorapi.SonataFlowPlatform) *operatorapi.RecoveryPolicySpec {
+	if plf == nil {
+		return nil
 	}
-
-	if workflow.Status.RecoverFailureAttempts >= constants.RecoverDeploymentErrorRetries {
-		workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.RedeploymentExhaustedReason,
-			"Can't recover workflow from failure after maximum attempts: %d", workflow.Status.RecoverFailureAttempts)
-		if _, updateErr := r.PerformStatusUpdate(ctx, workflow); updateErr != nil {
-			return ctrl.Result{}, nil, updateErr
-		}
-		return ctrl.Result{RequeueAfter: constants.RequeueRecoverDeploymentErrorInterval}, nil, nil
-	}
-
-	// TODO: we can improve deployment failures https://issues.redhat.com/browse/KOGITO-8812
-
-	// Guard to avoid consecutive reconciliations to mess with the recover interval
-	if !workflow.Status.LastTimeRecoverAttempt.IsZero() &&
-		metav1.Now().Sub(workflow.Status.LastTimeRecoverAttempt.Time).Minutes() > 10 {
-		return ctrl.Result{RequeueAfter: time.Minute * constants.RecoverDeploymentErrorInterval}, nil, nil
-	}
-
-	// let's try rolling out the deployment
-	if err := kubeutil.MarkDeploymentToRollout(deployment); err != nil {
-		return ctrl.Result{}, nil, err
-	}
-	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		updateErr := r.C.Update(ctx, deployment)
-		return updateErr
-	})
-
-	if retryErr != nil {
-		klog.V(log.E).ErrorS(retryErr, "Error during Deployment rollout")
-		return ctrl.Result{RequeueAfter: constants.RequeueRecoverDeploymentErrorInterval}, nil, nil
-	}
-
-	workflow.Status.RecoverFailureAttempts += 1
-	workflow.Status.LastTimeRecoverAttempt = metav1.Now()
-	if _, err := r.PerformStatusUpdate(ctx, workflow); err != nil {
-		return ctrl.Result{Requeue: false}, nil, err
-	}
-	return ctrl.Result{RequeueAfter: constants.RequeueRecoverDeploymentErrorInterval}, nil, nil
+	return plf.Spec.DevMode.Recovery
 }
 
-func (r *recoverFromFailureState) PostReconcile(ctx context.Context, workflow *operatorapi.SonataFlow) error {
-	//By default, we don't want to perform anything after the reconciliation, and so we will simply return no error
-	return nil
+// resetWorkflow resets the Running condition, so ensureRunningWorkflowState creates the workflow objects again.
+func resetWorkflow(workflow *operatorapi.SonataFlow) {
+	workflow.Status.Manager().MarkUnknown(api.RunningConditionType, "", "")
 }
@@ -22,9 +22,11 @@ package dev
 import (
 	"context"
 	"fmt"
+	"net"
 
 	openshiftv1 "github.com/openshift/api/route/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -47,6 +49,7 @@ func statusEnricher(ctx context.Context, c client.Client, workflow *operatorapi.
 		return nil, err
 	}
 
+	var ipaddr string
 	//If the service has got a Port that is a nodePort we have to use it to create the workflow's NodePort Endpoint
 	if service.Spec.Ports != nil && len(service.Spec.Ports) > 0 {
 		if port := findNodePortFromPorts(service.Spec.Ports); port > 0 {
@@ -61,7 +64,6 @@ func statusEnricher(ctx context.Context, c client.Client, workflow *operatorapi.
 			if err != nil {
 				return nil, err
 			}
-			var ipaddr string
 			for _, p := range podList.Items {
 				ipaddr = p.Status.HostIP
 				break
@@ -83,9 +85,47 @@ func statusEnricher(ctx context.Context, c client.Client, workflow *operatorapi.
 		}
 	}
 
+	if err = enrichDevModeStatus(ctx, c, workflow, ipaddr); err != nil {
+		return nil, err
+	}
 	return workflow, nil
 }
 
+// enrichDevModeStatus publishes the Dev UI and Swagger UI URLs and, if the debug Service exists, the address a remote debugger can attach to.
+// The debug address uses the node port when the node host IP is known, otherwise the in-cluster Service address.
+func enrichDevModeStatus(ctx context.Context, c client.Client, workflow *operatorapi.SonataFlow, hostIP string) error {
+	if workflow.Status.DevMode == nil {
+		workflow.Status.DevMode = &operatorapi.DevModeStatus{}
+	}
+	devMode := workflow.Status.DevMode
+	devMode.DevUIURL, devMode.SwaggerUIURL, devMode.DebugAddress = nil, nil, ""
+	if workflow.Status.Endpoint == nil {
+		return nil
+	}
+	devMode.DevUIURL = devToolURL(workflow.Status.Endpoint, devUIPath)
+	devMode.SwaggerUIURL = devToolURL(workflow.Status.Endpoint, swaggerUIPath)
+
+	debugService := &v1.Service{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: workflow.Namespace, Name: debugServiceName(workflow)}, debugService); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+	} else if len(debugService.Spec.Ports) > 0 {
+		port := debugService.Spec.Ports[0]
+		if nodePort := findNodePortFromPorts(debugService.Spec.Ports); nodePort > 0 && len(hostIP) > 0 {
+			devMode.DebugAddress = net.JoinHostPort(hostIP, fmt.Sprint(nodePort))
+		} else {
+			devMode.DebugAddress = net.JoinHostPort(debugService.Name+"."+debugService.Namespace, fmt.Sprint(port.Port))
+		}
+	}
+	return nil
+}
+
+// devToolURL returns the given Quarkus dev tool path on the same host of the workflow endpoint.
+func devToolURL(endpoint *apis.URL, toolPath string) *apis.URL {
+	return &apis.URL{Scheme: endpoint.Scheme, Host: endpoint.Host, Path: toolPath}
+}
+
 // findNodePortFromPorts returns the first Port in an array of ServicePort
 func findNodePortFromPorts(ports []v1.ServicePort) int {
 	if len(ports) > 0 {
@@ -122,5 +162,8 @@ func statusEnricherOpenShift(ctx context.Context, client client.Client, workflow
 	workflow.Status.Address = duckv1.Addressable{
 		URL: url,
 	}
+	if err = enrichDevModeStatus(ctx, client, workflow, ""); err != nil {
+		return nil, err
+	}
 	return workflow, nil
 }
@@ -86,6 +86,36 @@ func Test_enrichmentStatusOnOCP(t *testing.T) {
 	})
 }
 
+func Test_enrichmentDevModeStatus(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithDevProfile(t.Name())
+	workflow.Namespace = toK8SNamespace(t.Name())
+	platform := test.GetBasePlatformInReadyPhase(workflow.Namespace)
+	platform.Spec.DevMode.Debug = &apiv08.DevModeDebugSpec{}
+	service, _ := serviceCreator(workflow)
+	debugService, _ := debugServiceCreator(workflow, platform)
+	route := &openshiftv1.Route{}
+	route.Name = workflow.Name
+	route.Namespace = workflow.Namespace
+	route.Spec.Host = workflow.Name + "." + workflow.Namespace + ".apps-crc.testing"
+	client := test.NewKogitoClientBuilderWithOpenShift().WithRuntimeObjects(workflow, service, debugService, route).Build()
+
+	obj, err := statusEnricherOpenShift(context.TODO(), client, workflow)
+	assert.NoError(t, err)
+	devMode := obj.(*apiv08.SonataFlow).Status.DevMode
+	assert.NotNil(t, devMode)
+	assert.Equal(t, "http://"+route.Spec.Host+devUIPath, devMode.DevUIURL.String())
+	assert.Equal(t, "http://"+route.Spec.Host+swaggerUIPath, devMode.SwaggerUIURL.String())
+	assert.Equal(t, workflow.Name+debugServiceSuffix+"."+workflow.Namespace+":5005", devMode.DebugAddress)
+
+	// without the debug Service only the UIs are published
+	assert.NoError(t, client.Delete(context.TODO(), debugService))
+	obj, err = statusEnricherOpenShift(context.TODO(), client, workflow)
+	assert.NoError(t, err)
+	devMode = obj.(*apiv08.SonataFlow).Status.DevMode
+	assert.NotNil(t, devMode.DevUIURL)
+	assert.Empty(t, devMode.DebugAddress)
+}
+
 func toK8SNamespace(testName string) string {
 	return strings.ToLower(strings.Replace(strings.Split(testName, "/")[0], "_", "-", 1))
 }
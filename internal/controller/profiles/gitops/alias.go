@@ -24,5 +24,6 @@ import "github.com/apache/incubator-kie-kogito-serverless-operator/internal/cont
 
 var newDeploymentReconciler = preview.NewDeploymentReconciler
 var newObjectEnsurers = preview.NewObjectEnsurers
+var newRecoverFromFailureState = preview.NewRecoverFromFailureState
 
 type objectEnsurers = preview.ObjectEnsurers
@@ -45,6 +45,7 @@ func NewProfileForOpsReconciler(client client.Client, cfg *rest.Config, recorder
 	// the reconciliation state machine
 	stateMachine := common.NewReconciliationStateMachine(
 		&ensureBuildSkipped{StateSupport: support},
+		newRecoverFromFailureState(support),
 		&followDeployWorkflowState{StateSupport: support, ensurers: newObjectEnsurers(support)},
 	)
 	reconciler := &gitOpsProfile{
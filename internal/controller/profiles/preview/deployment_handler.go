@@ -19,12 +19,24 @@ package preview
 
 import (
 	"context"
+	"strings"
+	"time"
 
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/certmanager"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/gateway"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/keda"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/knative"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/log"
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/apache/incubator-kie-kogito-serverless-operator/api"
 	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
@@ -33,6 +45,7 @@ import (
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common/constants"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
+	kubeutil "github.com/apache/incubator-kie-kogito-serverless-operator/utils/kubernetes"
 )
 
 type DeploymentReconciler struct {
@@ -48,17 +61,23 @@ func NewDeploymentReconciler(stateSupport *common.StateSupport, ensurer *ObjectE
 }
 
 func (d *DeploymentReconciler) Reconcile(ctx context.Context, workflow *operatorapi.SonataFlow) (reconcile.Result, []client.Object, error) {
-	return d.reconcileWithImage(ctx, workflow, "")
+	return d.reconcileWithBuild(ctx, workflow, nil)
 }
 
-func (d *DeploymentReconciler) reconcileWithImage(ctx context.Context, workflow *operatorapi.SonataFlow, image string) (reconcile.Result, []client.Object, error) {
+func (d *DeploymentReconciler) reconcileWithBuild(ctx context.Context, workflow *operatorapi.SonataFlow, build *operatorapi.SonataFlowBuild) (reconcile.Result, []client.Object, error) {
 	// Checks if we need Knative installed and is not present.
 	if requires, err := d.ensureKnativeServingRequired(workflow); requires || err != nil {
 		return reconcile.Result{Requeue: false}, nil, err
 	}
 
+	// Move the canary release forward before computing the Knative Service traffic
+	canaryRequeue, err := common.SyncKnativeCanary(ctx, d.C, workflow)
+	if err != nil {
+		return reconcile.Result{Requeue: false}, nil, err
+	}
+
 	// Ensure objects
-	result, objs, err := d.ensureObjects(ctx, workflow, image)
+	result, objs, err := d.ensureObjects(ctx, workflow, build)
 	if err != nil || result.Requeue {
 		return result, objs, err
 	}
@@ -68,6 +87,12 @@ func (d *DeploymentReconciler) reconcileWithImage(ctx context.Context, workflow
 	if err != nil {
 		return reconcile.Result{Requeue: false}, nil, err
 	}
+	if canaryRequeue > 0 && (result.RequeueAfter == 0 || canaryRequeue < result.RequeueAfter) {
+		result.RequeueAfter = canaryRequeue
+	}
+	if err := common.CleanupBlueGreenDeployments(ctx, d.C, workflow); err != nil {
+		return reconcile.Result{Requeue: false}, nil, err
+	}
 
 	if _, err := d.PerformStatusUpdate(ctx, workflow); err != nil {
 		return reconcile.Result{Requeue: false}, nil, err
@@ -93,7 +118,7 @@ func (d *DeploymentReconciler) ensureKnativeServingRequired(workflow *operatorap
 	return false, nil
 }
 
-func (d *DeploymentReconciler) ensureObjects(ctx context.Context, workflow *operatorapi.SonataFlow, image string) (reconcile.Result, []client.Object, error) {
+func (d *DeploymentReconciler) ensureObjects(ctx context.Context, workflow *operatorapi.SonataFlow, build *operatorapi.SonataFlowBuild) (reconcile.Result, []client.Object, error) {
 	pl, _ := platform.GetActivePlatform(ctx, d.C, workflow.Namespace)
 	userPropsCM, _, err := d.ensurers.userPropsConfigMap.Ensure(ctx, workflow)
 	if err != nil {
@@ -111,14 +136,14 @@ func (d *DeploymentReconciler) ensureObjects(ctx context.Context, workflow *oper
 
 	deployment, deploymentOp, err :=
 		d.ensurers.DeploymentByDeploymentModel(workflow).Ensure(ctx, workflow, pl,
-			d.deploymentModelMutateVisitors(workflow, pl, image, userPropsCM.(*v1.ConfigMap), managedPropsCM.(*v1.ConfigMap))...)
+			d.deploymentModelMutateVisitors(workflow, pl, build, userPropsCM.(*v1.ConfigMap), managedPropsCM.(*v1.ConfigMap))...)
 	if err != nil {
 		workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.DeploymentUnavailableReason, "Unable to perform the deploy due to ", err)
 		_, _ = d.PerformStatusUpdate(ctx, workflow)
 		return reconcile.Result{}, nil, err
 	}
 
-	service, _, err := d.ensurers.ServiceByDeploymentModel(workflow).Ensure(ctx, workflow, common.ServiceMutateVisitor(workflow))
+	service, _, err := d.ensurers.ServiceByDeploymentModel(workflow).Ensure(ctx, workflow, common.ServiceMutateVisitor(workflow), common.TLSServiceMutateVisitor(workflow, pl))
 	if err != nil {
 		workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.DeploymentUnavailableReason, "Unable to make the service available due to ", err)
 		_, _ = d.PerformStatusUpdate(ctx, workflow)
@@ -126,6 +151,59 @@ func (d *DeploymentReconciler) ensureObjects(ctx context.Context, workflow *oper
 	}
 
 	objs := []client.Object{deployment, managedPropsCM, service}
+	ingress, err := d.ensureIngress(ctx, workflow)
+	if err != nil {
+		workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.DeploymentUnavailableReason, "Unable to expose the service due to ", err)
+		_, _ = d.PerformStatusUpdate(ctx, workflow)
+		return reconcile.Result{}, nil, err
+	}
+	if ingress != nil {
+		objs = append(objs, ingress)
+	}
+	route, err := d.ensureHTTPRoute(ctx, workflow)
+	if err != nil {
+		workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.DeploymentUnavailableReason, "Unable to route the traffic to the service due to ", err)
+		_, _ = d.PerformStatusUpdate(ctx, workflow)
+		return reconcile.Result{}, nil, err
+	}
+	if route != nil {
+		objs = append(objs, route)
+	}
+	pdb, err := d.ensurePodDisruptionBudget(ctx, workflow)
+	if err != nil {
+		return reconcile.Result{}, nil, err
+	}
+	if pdb != nil {
+		objs = append(objs, pdb)
+	}
+	scaledObject, err := d.ensureScaledObject(ctx, workflow, pl)
+	if err != nil {
+		return reconcile.Result{}, nil, err
+	}
+	if scaledObject != nil {
+		objs = append(objs, scaledObject)
+	}
+	certificate, err := d.ensureCertificate(ctx, workflow, pl)
+	if err != nil {
+		return reconcile.Result{}, nil, err
+	}
+	if certificate != nil {
+		objs = append(objs, certificate)
+	}
+	cronJob, err := d.ensureCronJob(ctx, workflow, pl)
+	if err != nil {
+		return reconcile.Result{}, nil, err
+	}
+	if cronJob != nil {
+		objs = append(objs, cronJob)
+	}
+	networkPolicy, err := d.ensureNetworkPolicy(ctx, workflow, pl, managedPropsCM.(*v1.ConfigMap))
+	if err != nil {
+		return reconcile.Result{}, nil, err
+	}
+	if networkPolicy != nil {
+		objs = append(objs, networkPolicy)
+	}
 	eventingObjs, err := common.NewKnativeEventingHandler(d.StateSupport, pl).Ensure(ctx, workflow)
 	if err != nil {
 		return reconcile.Result{}, nil, err
@@ -150,6 +228,146 @@ func (d *DeploymentReconciler) ensureObjects(ctx context.Context, workflow *oper
 	return reconcile.Result{}, objs, nil
 }
 
+// ensureIngress exposes the workflow service as defined in the workflow exposure and sets the workflow endpoint accordingly.
+func (d *DeploymentReconciler) ensureIngress(ctx context.Context, workflow *operatorapi.SonataFlow) (client.Object, error) {
+	if workflow.Spec.Exposure == nil || !workflow.IsKubernetesDeployment() {
+		// the endpoint is resolved again from the HTTPRoute, if any
+		ingress := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: workflow.Name, Namespace: workflow.Namespace}}
+		if err := kubeutil.DeleteIfExists(ctx, d.C, ingress); err != nil {
+			return nil, err
+		}
+		workflow.Status.Endpoint = nil
+		return nil, nil
+	}
+	ingress, _, err := d.ensurers.ingress.Ensure(ctx, workflow, common.IngressMutateVisitor(workflow))
+	if err != nil {
+		return nil, err
+	}
+	workflow.Status.Endpoint = kubeutil.ExposureURL(workflow.Spec.Exposure)
+	return ingress, nil
+}
+
+// ensureHTTPRoute routes the traffic from the Gateway referenced by the workflow to the workflow service.
+// If the workflow isn't exposed by an Ingress, the workflow endpoint is resolved from the Gateway listener.
+func (d *DeploymentReconciler) ensureHTTPRoute(ctx context.Context, workflow *operatorapi.SonataFlow) (client.Object, error) {
+	if workflow.Spec.HTTPRoute == nil || !workflow.IsKubernetesDeployment() {
+		route := &gwapi.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: workflow.Name, Namespace: workflow.Namespace}}
+		return nil, kubeutil.DeleteIfExists(ctx, d.C, route)
+	}
+	avail, err := gateway.GetGatewayAPIAvailability(d.Cfg)
+	if err != nil {
+		return nil, err
+	}
+	if !avail {
+		d.Recorder.Event(workflow, v1.EventTypeWarning, "GatewayAPINotAvailable",
+			"Gateway API is not available in this cluster, can't route the traffic to the workflow. Please install the Gateway API CRDs or remove the workflow HTTPRoute")
+		return nil, nil
+	}
+	route, _, err := d.ensurers.httpRoute.Ensure(ctx, workflow, common.HTTPRouteMutateVisitor(workflow))
+	if err != nil {
+		return nil, err
+	}
+	if workflow.Spec.Exposure == nil {
+		url, err := gateway.ResolveHTTPRouteURL(ctx, d.C, route.(*gwapi.HTTPRoute))
+		if err != nil {
+			klog.V(log.I).InfoS("Unable to resolve the workflow endpoint from the Gateway", "workflow", workflow.Name, "error", err)
+			return route, nil
+		}
+		workflow.Status.Endpoint = url
+	}
+	return route, nil
+}
+
+// ensurePodDisruptionBudget protects the workflow pods from voluntary disruptions when the workflow runs more than one replica.
+func (d *DeploymentReconciler) ensurePodDisruptionBudget(ctx context.Context, workflow *operatorapi.SonataFlow) (client.Object, error) {
+	if !workflow.IsDisruptionBudgetRequired() {
+		pdb := &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: workflow.Name, Namespace: workflow.Namespace}}
+		return nil, kubeutil.DeleteIfExists(ctx, d.C, pdb)
+	}
+	pdb, _, err := d.ensurers.podDisruptionBudget.Ensure(ctx, workflow, common.PodDisruptionBudgetMutateVisitor(workflow))
+	return pdb, err
+}
+
+// ensureScaledObject scales the workflow on the backlog of its consumed events if KEDA is available in the cluster.
+func (d *DeploymentReconciler) ensureScaledObject(ctx context.Context, workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform) (client.Object, error) {
+	if !workflow.IsEventDrivenAutoscalingEnabled() {
+		return nil, kubeutil.DeleteIfExists(ctx, d.C, keda.NewScaledObject(workflow.Name, workflow.Namespace))
+	}
+	avail, err := keda.GetKedaAvailability(d.Cfg)
+	if err != nil {
+		return nil, err
+	}
+	if !avail {
+		d.Recorder.Event(workflow, v1.EventTypeWarning, "KedaNotAvailable",
+			"KEDA is not available in this cluster, can't scale the workflow on its events backlog. Please install KEDA or remove the workflow eventDrivenAutoscaling")
+		return nil, nil
+	}
+	unscalable, err := common.GetUnscalableEvents(workflow, pl)
+	if err != nil {
+		return nil, err
+	}
+	if len(unscalable) > 0 {
+		d.Recorder.Eventf(workflow, v1.EventTypeWarning, "EventsNotScalable",
+			"The events %s are delivered by a Knative Broker not backed by Kafka, their backlog is ignored by the event-driven autoscaling", strings.Join(unscalable, ", "))
+	}
+	scaledObject, _, err := d.ensurers.scaledObject.Ensure(ctx, workflow, pl, common.ScaledObjectMutateVisitor(workflow, pl))
+	if err == nil && scaledObject == nil {
+		// none of the events backlog can be read
+		return nil, kubeutil.DeleteIfExists(ctx, d.C, keda.NewScaledObject(workflow.Name, workflow.Namespace))
+	}
+	return scaledObject, err
+}
+
+// ensureCertificate requests the certificate serving the workflow over HTTPS if cert-manager is available in the cluster.
+func (d *DeploymentReconciler) ensureCertificate(ctx context.Context, workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform) (client.Object, error) {
+	if !certmanager.UsesTLS(workflow, pl) {
+		return nil, kubeutil.DeleteIfExists(ctx, d.C, certmanager.NewCertificate(workflow.Name, workflow.Namespace))
+	}
+	avail, err := certmanager.GetCertManagerAvailability(d.Cfg)
+	if err != nil {
+		return nil, err
+	}
+	if !avail {
+		d.Recorder.Event(workflow, v1.EventTypeWarning, "CertManagerNotAvailable",
+			"cert-manager is not available in this cluster, can't request the workflow certificate. Please install cert-manager or remove the platform TLS")
+		return nil, nil
+	}
+	certificate, _, err := d.ensurers.certificate.Ensure(ctx, workflow, pl, common.CertificateMutateVisitor(workflow, pl))
+	return certificate, err
+}
+
+// ensureCronJob starts the workflow on the cron schedule defined in the flow start and reflects its trigger times in the
+// workflow status.
+func (d *DeploymentReconciler) ensureCronJob(ctx context.Context, workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform) (client.Object, error) {
+	if workflow.GetStartCron() == nil || workflow.IsJobDeployment() {
+		if workflow.Spec.Flow.Start != nil && workflow.Spec.Flow.Start.Schedule != nil && len(workflow.Spec.Flow.Start.Schedule.Interval) > 0 {
+			d.Recorder.Event(workflow, v1.EventTypeWarning, "ScheduleIntervalNotSupported",
+				"The workflow start schedule interval is not supported, the workflow won't be started periodically. Please use a cron schedule instead")
+		}
+		workflow.Status.Schedule = nil
+		cronJob := &batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: workflow.Name, Namespace: workflow.Namespace}}
+		return nil, kubeutil.DeleteIfExists(ctx, d.C, cronJob)
+	}
+	cronJob, _, err := d.ensurers.cronJob.Ensure(ctx, workflow, pl, common.CronJobMutateVisitor(workflow, pl))
+	if err != nil {
+		return nil, err
+	}
+	if err = common.SyncScheduleStatus(workflow, cronJob.(*batchv1.CronJob), time.Now()); err != nil {
+		return nil, err
+	}
+	return cronJob, nil
+}
+
+// ensureNetworkPolicy restricts the workflow traffic to the one it requires if the platform generates NetworkPolicies.
+func (d *DeploymentReconciler) ensureNetworkPolicy(ctx context.Context, workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform, managedPropsCM *v1.ConfigMap) (client.Object, error) {
+	if !pl.IsNetworkPoliciesEnabled() {
+		policy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: workflow.Name, Namespace: workflow.Namespace}}
+		return nil, kubeutil.DeleteIfExists(ctx, d.C, policy)
+	}
+	policy, _, err := d.ensurers.networkPolicy.Ensure(ctx, workflow, pl, common.NetworkPolicyMutateVisitor(workflow, pl, managedPropsCM))
+	return policy, err
+}
+
 func (d *DeploymentReconciler) ensureServiceMonitor(ctx context.Context, workflow *operatorapi.SonataFlow, pl *operatorapi.SonataFlowPlatform) (client.Object, error) {
 	if monitoring.IsMonitoringEnabled(pl) {
 		serviceMonitor, _, err := d.ensurers.ServiceMonitorByDeploymentModel(workflow).Ensure(ctx, workflow)
@@ -161,10 +379,15 @@ func (d *DeploymentReconciler) ensureServiceMonitor(ctx context.Context, workflo
 func (d *DeploymentReconciler) deploymentModelMutateVisitors(
 	workflow *operatorapi.SonataFlow,
 	plf *operatorapi.SonataFlowPlatform,
-	image string,
+	build *operatorapi.SonataFlowBuild,
 	userPropsCM *v1.ConfigMap,
 	managedPropsCM *v1.ConfigMap) []common.MutateVisitor {
 
+	image := ""
+	if build != nil {
+		image = build.Status.ImageTag
+	}
+
 	if workflow.IsKnativeDeployment() {
 		return []common.MutateVisitor{common.KServiceMutateVisitor(workflow, plf),
 			common.ImageKServiceMutateVisitor(workflow, image),
@@ -178,12 +401,14 @@ func (d *DeploymentReconciler) deploymentModelMutateVisitors(
 			mountConfigMapsMutateVisitor(workflow, userPropsCM, managedPropsCM),
 			addOpenShiftImageTriggerDeploymentMutateVisitor(workflow, image),
 			common.ImageDeploymentMutateVisitor(workflow, image),
+			common.BuildDeploymentMutateVisitor(workflow, build),
 			common.RestoreDeploymentVolumeAndVolumeMountMutateVisitor(),
 			common.RolloutDeploymentIfCMChangedMutateVisitor(workflow, userPropsCM, managedPropsCM),
 		}
 	}
 	return []common.MutateVisitor{common.DeploymentMutateVisitor(workflow, plf),
 		common.ImageDeploymentMutateVisitor(workflow, image),
+		common.BuildDeploymentMutateVisitor(workflow, build),
 		mountConfigMapsMutateVisitor(workflow, userPropsCM, managedPropsCM),
 		common.RestoreDeploymentVolumeAndVolumeMountMutateVisitor(),
 		common.RolloutDeploymentIfCMChangedMutateVisitor(workflow, userPropsCM, managedPropsCM)}
@@ -20,6 +20,7 @@ package preview
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/apache/incubator-kie-kogito-serverless-operator/api/metadata"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
@@ -27,12 +28,19 @@ import (
 	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/workflowproj"
 	"github.com/magiconair/properties"
+	cncfmodel "github.com/serverlessworkflow/sdk-go/v2/model"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 type fakeDeploymentReconciler struct {
@@ -51,7 +59,7 @@ func Test_CheckDeploymentModelIsKnative(t *testing.T) {
 	utils.SetDiscoveryClient(test.CreateFakeKnativeAndMonitoringDiscoveryClient())
 	handler := NewDeploymentReconciler(stateSupport, NewObjectEnsurers(stateSupport))
 
-	result, objects, err := handler.ensureObjects(context.TODO(), workflow, "")
+	result, objects, err := handler.ensureObjects(context.TODO(), workflow, nil)
 	assert.NoError(t, err)
 	assert.NotEmpty(t, objects)
 	assert.True(t, result.Requeue)
@@ -224,3 +232,219 @@ func Test_CheckDeploymentUnchangedAfterCMChangeOtherKeys(t *testing.T) {
 		}
 	}
 }
+
+func Test_CheckExposureCreatesIngress(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithPreviewProfile(t.Name())
+	workflow.Spec.Exposure = &v1alpha08.ExposureSpec{
+		Host:             "greeting.example.com",
+		IngressClassName: &[]string{"nginx"}[0],
+		TLSSecretName:    "greeting-tls",
+		Annotations:      map[string]string{"nginx.ingress.kubernetes.io/proxy-body-size": "8m"},
+	}
+
+	client := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(workflow).
+		WithStatusSubresource(workflow).
+		Build()
+	stateSupport := fakeReconcilerSupport(client)
+	utils.SetDiscoveryClient(test.CreateFakeKnativeAndMonitoringDiscoveryClient())
+	handler := NewDeploymentReconciler(stateSupport, NewObjectEnsurers(stateSupport))
+
+	_, _, err := handler.ensureObjects(context.TODO(), workflow, nil)
+	assert.NoError(t, err)
+	ingress := &networkingv1.Ingress{}
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name}, ingress))
+	assert.Equal(t, "nginx", *ingress.Spec.IngressClassName)
+	assert.Equal(t, "8m", ingress.Annotations["nginx.ingress.kubernetes.io/proxy-body-size"])
+	assert.Equal(t, "greeting.example.com", ingress.Spec.Rules[0].Host)
+	assert.Equal(t, workflow.Name, ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service.Name)
+	assert.Equal(t, "greeting-tls", ingress.Spec.TLS[0].SecretName)
+	assert.Equal(t, "https://greeting.example.com/", workflow.Status.Endpoint.String())
+
+	// removing the exposure deletes the ingress
+	workflow.Spec.Exposure = nil
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, workflow.Status.Endpoint)
+	assert.True(t, errors.IsNotFound(client.Get(context.TODO(), types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name}, ingress)))
+}
+
+func Test_CheckHTTPRouteCreatesRoute(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithPreviewProfile(t.Name())
+	workflow.Spec.HTTPRoute = &v1alpha08.HTTPRouteSpec{
+		GatewayRef: v1alpha08.GatewayReference{Name: "external", SectionName: "https"},
+		Hostnames:  []string{"greeting.example.com"},
+		Path:       "/greeting",
+	}
+	gateway := &gwapi.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "external", Namespace: workflow.Namespace},
+		Spec: gwapi.GatewaySpec{
+			Listeners: []gwapi.Listener{
+				{Name: "http", Protocol: gwapi.HTTPProtocolType, Port: 80},
+				{Name: "https", Protocol: gwapi.HTTPSProtocolType, Port: 443},
+			},
+		},
+	}
+
+	client := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(workflow, gateway).
+		WithStatusSubresource(workflow).
+		Build()
+	stateSupport := fakeReconcilerSupport(client)
+	utils.SetDiscoveryClient(test.CreateFakeGatewayAPIDiscoveryClient())
+	handler := NewDeploymentReconciler(stateSupport, NewObjectEnsurers(stateSupport))
+
+	_, _, err := handler.ensureObjects(context.TODO(), workflow, nil)
+	assert.NoError(t, err)
+	route := &gwapi.HTTPRoute{}
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name}, route))
+	assert.Equal(t, gwapi.ObjectName("external"), route.Spec.ParentRefs[0].Name)
+	assert.Equal(t, gwapi.SectionName("https"), *route.Spec.ParentRefs[0].SectionName)
+	assert.Equal(t, gwapi.Hostname("greeting.example.com"), route.Spec.Hostnames[0])
+	assert.Equal(t, "/greeting", *route.Spec.Rules[0].Matches[0].Path.Value)
+	assert.Equal(t, gwapi.ObjectName(workflow.Name), route.Spec.Rules[0].BackendRefs[0].Name)
+	assert.Equal(t, "https://greeting.example.com/greeting", workflow.Status.Endpoint.String())
+
+	// removing the route deletes it
+	workflow.Spec.HTTPRoute = nil
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, workflow.Status.Endpoint)
+	assert.True(t, errors.IsNotFound(client.Get(context.TODO(), types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name}, route)))
+
+	// the route is also removed while the workflow is exposed by an Ingress
+	workflow.Spec.HTTPRoute = &v1alpha08.HTTPRouteSpec{GatewayRef: v1alpha08.GatewayReference{Name: "external"}}
+	workflow.Spec.Exposure = &v1alpha08.ExposureSpec{Host: "greeting.example.com"}
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name}, route))
+	workflow.Spec.HTTPRoute = nil
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://greeting.example.com/", workflow.Status.Endpoint.String())
+	assert.True(t, errors.IsNotFound(client.Get(context.TODO(), types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name}, route)))
+}
+
+func Test_CheckReplicasCreatesPodDisruptionBudget(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithPreviewProfile(t.Name())
+	workflow.Spec.PodTemplate.Replicas = utils.Pint(2)
+
+	client := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(workflow).
+		WithStatusSubresource(workflow).
+		Build()
+	stateSupport := fakeReconcilerSupport(client)
+	utils.SetDiscoveryClient(test.CreateFakeKnativeAndMonitoringDiscoveryClient())
+	handler := NewDeploymentReconciler(stateSupport, NewObjectEnsurers(stateSupport))
+
+	_, _, err := handler.ensureObjects(context.TODO(), workflow, nil)
+	assert.NoError(t, err)
+	pdb := &policyv1.PodDisruptionBudget{}
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name}, pdb))
+	assert.Equal(t, int32(1), pdb.Spec.MaxUnavailable.IntVal)
+
+	// scaling down to a single replica removes the budget
+	workflow.Spec.PodTemplate.Replicas = utils.Pint(1)
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, nil)
+	assert.NoError(t, err)
+	assert.True(t, errors.IsNotFound(client.Get(context.TODO(), types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name}, pdb)))
+}
+
+func Test_CheckCronStartCreatesCronJob(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithPreviewProfile(t.Name())
+	workflow.Spec.Flow.Start = &cncfmodel.Start{
+		StateName: workflow.Spec.Flow.States[0].Name,
+		Schedule:  &cncfmodel.Schedule{Cron: &cncfmodel.Cron{Expression: "0 * * * *"}},
+	}
+
+	client := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(workflow).
+		WithStatusSubresource(workflow).
+		Build()
+	stateSupport := fakeReconcilerSupport(client)
+	utils.SetDiscoveryClient(test.CreateFakeKnativeAndMonitoringDiscoveryClient())
+	handler := NewDeploymentReconciler(stateSupport, NewObjectEnsurers(stateSupport))
+
+	_, _, err := handler.ensureObjects(context.TODO(), workflow, nil)
+	assert.NoError(t, err)
+	cronJob := &batchv1.CronJob{}
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name}, cronJob))
+	assert.Equal(t, "0 * * * *", cronJob.Spec.Schedule)
+	assert.Equal(t, "0 * * * *", workflow.Status.Schedule.Cron)
+	assert.NotNil(t, workflow.Status.Schedule.NextTriggerTime)
+
+	// changing the cron updates the CronJob
+	workflow.Spec.Flow.Start.Schedule.Cron.Expression = "*/10 * * * *"
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name}, cronJob))
+	assert.Equal(t, "*/10 * * * *", cronJob.Spec.Schedule)
+
+	// removing the schedule deletes the CronJob
+	workflow.Spec.Flow.Start.Schedule = nil
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, workflow.Status.Schedule)
+	assert.True(t, errors.IsNotFound(client.Get(context.TODO(), types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name}, cronJob)))
+}
+
+func Test_CheckDeploymentModelIsJob(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithPreviewProfile(t.Name())
+	workflow.Spec.PodTemplate.DeploymentModel = v1alpha08.JobDeploymentModel
+
+	client := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(workflow).
+		WithStatusSubresource(workflow).
+		Build()
+	stateSupport := fakeReconcilerSupport(client)
+	utils.SetDiscoveryClient(test.CreateFakeKnativeAndMonitoringDiscoveryClient())
+	handler := NewDeploymentReconciler(stateSupport, NewObjectEnsurers(stateSupport))
+
+	_, _, err := handler.ensureObjects(context.TODO(), workflow, nil)
+	assert.NoError(t, err)
+	job := &batchv1.Job{}
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name}, job))
+	assert.Equal(t, v1alpha08.DefaultContainerName, job.Spec.Template.Spec.InitContainers[0].Name)
+	assert.Equal(t, workflow.Name, workflow.Status.Job.Name)
+	// the workflow run to completion isn't exposed
+	assert.True(t, errors.IsNotFound(client.Get(context.TODO(), types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name}, &corev1.Service{})))
+	assert.True(t, errors.IsNotFound(client.Get(context.TODO(), types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name}, &v1.Deployment{})))
+}
+
+func Test_CheckBlueGreenRolloutAfterRebuild(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithPreviewProfile(t.Name())
+	workflow.Spec.PodTemplate.BlueGreen = &v1alpha08.BlueGreenRolloutSpec{}
+	build := &v1alpha08.SonataFlowBuild{ObjectMeta: metav1.ObjectMeta{Name: workflow.Name, Namespace: workflow.Namespace}}
+	build.Status.ImageTag = "quay.io/apache/greeting:latest"
+	build.Status.CompletedAt = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+
+	client := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(workflow).
+		WithStatusSubresource(workflow).
+		Build()
+	stateSupport := fakeReconcilerSupport(client)
+	utils.SetDiscoveryClient(test.CreateFakeKnativeAndMonitoringDiscoveryClient())
+	handler := NewDeploymentReconciler(stateSupport, NewObjectEnsurers(stateSupport))
+	blue := types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name + "-blue"}
+
+	_, _, err := handler.ensureObjects(context.TODO(), workflow, build)
+	assert.NoError(t, err)
+	deployment := &v1.Deployment{}
+	assert.NoError(t, client.Get(context.TODO(), blue, deployment))
+	deployment.Status.Conditions = []v1.DeploymentCondition{{Type: v1.DeploymentAvailable, Status: corev1.ConditionTrue}}
+	assert.NoError(t, client.Status().Update(context.TODO(), deployment))
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, build)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha08.BlueDeploymentColor, workflow.Status.BlueGreen.ActiveColor)
+	assert.Empty(t, workflow.Status.BlueGreen.PreviewColor)
+
+	// the rebuild pushes the same image tag, it must be rolled out in a new color anyway
+	build.Status.CompletedAt = &metav1.Time{Time: time.Now()}
+	_, _, err = handler.ensureObjects(context.TODO(), workflow, build)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha08.BlueDeploymentColor, workflow.Status.BlueGreen.ActiveColor)
+	assert.Equal(t, v1alpha08.GreenDeploymentColor, workflow.Status.BlueGreen.PreviewColor)
+	green := &v1.Deployment{}
+	assert.NoError(t, client.Get(context.TODO(), types.NamespacedName{Namespace: workflow.Namespace, Name: workflow.Name + "-green"}, green))
+	assert.Equal(t, build.Status.CompletedAt.UTC().Format(time.RFC3339), green.Spec.Template.Annotations[metadata.BuildCompletedAt])
+}
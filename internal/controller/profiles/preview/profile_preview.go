@@ -55,10 +55,28 @@ const (
 type ObjectEnsurers struct {
 	// deployment for this ensurer. Don't call it directly, use DeploymentByDeploymentModel instead
 	deployment common.ObjectEnsurerWithPlatform
+	// blueGreenDeployment blue/green Deployments for this ensurer. Don't call it directly, use DeploymentByDeploymentModel instead
+	blueGreenDeployment common.ObjectEnsurerWithPlatform
 	// kservice Knative Serving deployment for this ensurer. Don't call it directly, use DeploymentByDeploymentModel instead
 	kservice common.ObjectEnsurerWithPlatform
+	// job running a single workflow instance to completion. Don't call it directly, use DeploymentByDeploymentModel instead
+	job common.ObjectEnsurerWithPlatform
 	// service for this ensurer. Don't call it directly, use ServiceByDeploymentModel instead
 	service common.ObjectEnsurer
+	// ingress exposing the service outside the cluster, if required by the workflow
+	ingress common.ObjectEnsurer
+	// httpRoute routing the traffic from a Gateway to the service, if required by the workflow
+	httpRoute common.ObjectEnsurer
+	// podDisruptionBudget protecting the workflow pods, if the workflow runs more than one replica
+	podDisruptionBudget common.ObjectEnsurer
+	// scaledObject KEDA autoscaling of the workflow on its events backlog, if required by the workflow
+	scaledObject common.ObjectEnsurerWithPlatform
+	// certificate requested from cert-manager to serve the workflow over HTTPS, if the platform configures TLS
+	certificate common.ObjectEnsurerWithPlatform
+	// cronJob starting the workflow on schedule, if the flow start defines a cron
+	cronJob common.ObjectEnsurerWithPlatform
+	// networkPolicy allowing only the traffic required by the workflow, if the platform generates NetworkPolicies
+	networkPolicy common.ObjectEnsurerWithPlatform
 	// serviceMonitor for this ensurer. Don't call it directly, use ServiceMonitorByDeploymentModel instead
 	serviceMonitor        common.ObjectEnsurer
 	userPropsConfigMap    common.ObjectEnsurer
@@ -70,6 +88,12 @@ func (o *ObjectEnsurers) DeploymentByDeploymentModel(workflow *v1alpha08.SonataF
 	if workflow.IsKnativeDeployment() {
 		return o.kservice
 	}
+	if workflow.IsJobDeployment() {
+		return o.job
+	}
+	if workflow.IsBlueGreenDeployment() {
+		return o.blueGreenDeployment
+	}
 	return o.deployment
 }
 
@@ -79,13 +103,17 @@ func (o *ObjectEnsurers) ServiceByDeploymentModel(workflow *v1alpha08.SonataFlow
 		// Knative Serving handles the service
 		return common.NewNoopObjectEnsurer()
 	}
+	if workflow.IsJobDeployment() {
+		// the workflow instance run to completion isn't exposed
+		return common.NewNoopObjectEnsurer()
+	}
 	return o.service
 }
 
 // ServiceMonitorByDeploymentModel gets the service monitor ensurer based on the SonataFlow deployment model
 func (o *ObjectEnsurers) ServiceMonitorByDeploymentModel(workflow *v1alpha08.SonataFlow) common.ObjectEnsurer {
-	if workflow.IsKnativeDeployment() {
-		// Do not create service monitor for workflows deployed as Knative service
+	if !workflow.IsKubernetesDeployment() {
+		// Do not create service monitor for workflows deployed as Knative service or run as a Job
 		return common.NewNoopObjectEnsurer()
 	}
 	return o.serviceMonitor
@@ -95,8 +123,17 @@ func (o *ObjectEnsurers) ServiceMonitorByDeploymentModel(workflow *v1alpha08.Son
 func NewObjectEnsurers(support *common.StateSupport) *ObjectEnsurers {
 	return &ObjectEnsurers{
 		deployment:            common.NewObjectEnsurerWithPlatform(support.C, common.DeploymentCreator),
+		blueGreenDeployment:   common.NewBlueGreenDeploymentEnsurer(support.C),
 		kservice:              common.NewObjectEnsurerWithPlatform(support.C, common.KServiceCreator),
+		job:                   common.NewJobEnsurer(support.C),
 		service:               common.NewObjectEnsurer(support.C, common.ServiceCreator),
+		ingress:               common.NewObjectEnsurer(support.C, common.IngressCreator),
+		httpRoute:             common.NewObjectEnsurer(support.C, common.HTTPRouteCreator),
+		podDisruptionBudget:   common.NewObjectEnsurer(support.C, common.PodDisruptionBudgetCreator),
+		scaledObject:          common.NewObjectEnsurerWithPlatform(support.C, common.ScaledObjectCreator),
+		certificate:           common.NewObjectEnsurerWithPlatform(support.C, common.CertificateCreator),
+		cronJob:               common.NewObjectEnsurerWithPlatform(support.C, common.CronJobCreator),
+		networkPolicy:         common.NewObjectEnsurerWithPlatform(support.C, common.NetworkPolicyCreator),
 		serviceMonitor:        common.NewObjectEnsurer(support.C, common.ServiceMonitorCreator),
 		userPropsConfigMap:    common.NewObjectEnsurer(support.C, common.UserPropsConfigMapCreator),
 		managedPropsConfigMap: common.NewObjectEnsurerWithPlatform(support.C, common.ManagedPropsConfigMapCreator),
@@ -116,6 +153,7 @@ func NewProfileReconciler(client client.Client, cfg *rest.Config, recorder recor
 	stateMachine := common.NewReconciliationStateMachine(
 		&newBuilderState{StateSupport: support, ensurers: NewObjectEnsurers(support)},
 		&followBuildStatusState{StateSupport: support},
+		NewRecoverFromFailureState(support),
 		&deployWithBuildWorkflowState{StateSupport: support, ensurers: NewObjectEnsurers(support)},
 	)
 	reconciler := &previewProfile{
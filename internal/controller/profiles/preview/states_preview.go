@@ -22,7 +22,9 @@ package preview
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sort"
+	"time"
 
 	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
 	corev1 "k8s.io/api/core/v1"
@@ -146,8 +148,9 @@ func (h *followBuildStatusState) Do(ctx context.Context, workflow *operatorapi.S
 
 	if build.Status.BuildPhase == operatorapi.BuildPhaseSucceeded {
 		klog.V(log.I).InfoS("Workflow build has finished")
-		if workflow.Status.IsReady() {
+		if workflow.Status.IsReady() && !workflow.IsBlueGreenDeployment() {
 			// Rollout our deployment to take the latest changes in the new image.
+			// Blue/green workflows roll out the new image in the preview color instead of restarting the live Deployment.
 			if err := common.DeploymentManager(h.C).RolloutDeployment(ctx, workflow); err != nil {
 				return ctrl.Result{RequeueAfter: constants.RequeueAfterFailure}, nil, err
 			}
@@ -158,6 +161,11 @@ func (h *followBuildStatusState) Do(ctx context.Context, workflow *operatorapi.S
 		workflow.Status.Manager().MarkTrue(api.BuiltConditionType)
 		_, err = h.PerformStatusUpdate(ctx, workflow)
 		h.Recorder.Eventf(workflow, corev1.EventTypeNormal, api.BuildSuccessfulReason, "Workflow %s build has been finished successfully.", workflow.Name)
+	} else if build.Status.BuildPhase == operatorapi.BuildPhaseFailed && build.Status.NextRetryAt != nil {
+		// keep following the build while it's going to be retried
+		workflow.Status.Manager().MarkFalse(api.BuiltConditionType, api.BuildIsRunningReason,
+			"Build failed, retrying at %s. Error: %s", build.Status.NextRetryAt.Format(time.RFC3339), build.Status.Error)
+		_, err = h.PerformStatusUpdate(ctx, workflow)
 	} else if build.Status.BuildPhase == operatorapi.BuildPhaseFailed || build.Status.BuildPhase == operatorapi.BuildPhaseError {
 		workflow.Status.Manager().MarkFalse(api.BuiltConditionType, api.BuildFailedReason,
 			"Workflow %s build failed. Error: %s", workflow.Name, build.Status.Error)
@@ -213,7 +221,11 @@ func (h *deployWithBuildWorkflowState) Do(ctx context.Context, workflow *operato
 	if err != nil {
 		return ctrl.Result{}, nil, err
 	}
-	if hasChanged { // Let's check that the 2 resWorkflowDef definition are different
+	gitChanged, err := buildManager.SyncGitSource(workflow, build)
+	if err != nil {
+		return ctrl.Result{}, nil, err
+	}
+	if hasChanged || gitChanged { // Let's check that the 2 resWorkflowDef definition are different
 		if err = buildManager.MarkToRestart(build); err != nil {
 			return ctrl.Result{}, nil, err
 		}
@@ -225,7 +237,7 @@ func (h *deployWithBuildWorkflowState) Do(ctx context.Context, workflow *operato
 	}
 
 	// didn't change, business as usual
-	result, objs, err := NewDeploymentReconciler(h.StateSupport, h.ensurers).reconcileWithImage(ctx, workflow, build.Status.ImageTag)
+	result, objs, err := NewDeploymentReconciler(h.StateSupport, h.ensurers).reconcileWithBuild(ctx, workflow, build)
 	if err != nil {
 		workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.DeploymentFailureReason, fmt.Sprintf("Error in deploy the workflow:%s", err))
 		_, err = h.PerformStatusUpdate(ctx, workflow)
@@ -286,10 +298,11 @@ func (h *deployWithBuildWorkflowState) cleanupOutdatedRevisions(ctx context.Cont
 	}
 	// Sort the revisions based on creation timestamp
 	sortRevisions(revisionList.Items)
-	// Clean up previous revisions that do not have K_SINK injected
+	// Clean up previous revisions that do not have K_SINK injected, unless they still receive traffic
+	inUse := common.TrafficRevisions(workflow)
 	for i := 0; i < len(revisionList.Items)-1; i++ {
 		revision := &revisionList.Items[i]
-		if !containsKSink(revision) {
+		if !containsKSink(revision) && !slices.Contains(inUse, revision.Name) {
 			klog.V(log.I).InfoS("Revision %s does not have K_SINK injected and can be cleaned up.", revision.Name)
 			if err := h.C.Delete(ctx, revision, &client.DeleteOptions{}); err != nil {
 				return err
@@ -324,3 +337,51 @@ func (a CreationTimestamp) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
 func sortRevisions(revisions []servingv1.Revision) {
 	sort.Sort(CreationTimestamp(revisions))
 }
+
+// NewRecoverFromFailureState creates the reconciliation state recovering the failed workflow Deployments
+// when the platform enables the recovery.
+func NewRecoverFromFailureState(support *common.StateSupport) *RecoverFromFailureState {
+	return &RecoverFromFailureState{
+		RecoverFromFailureState: common.NewRecoverFromFailureState(support, recoveryPolicy, resetWorkflow),
+	}
+}
+
+// RecoverFromFailureState recovers the workflows with a built or a prebuilt image whose Deployment has failed.
+// Only the workflows using the "kubernetes" deployment model without blue-green rollouts are recovered,
+// a change in the workflow spec is always reconciled by the deployment states instead.
+type RecoverFromFailureState struct {
+	*common.RecoverFromFailureState
+}
+
+func (r *RecoverFromFailureState) CanReconcile(workflow *operatorapi.SonataFlow) bool {
+	running := workflow.Status.GetCondition(api.RunningConditionType)
+	if !running.IsFalse() || (running.Reason != api.DeploymentFailureReason && running.Reason != api.RedeploymentExhaustedReason) {
+		return false
+	}
+	built := workflow.Status.GetCondition(api.BuiltConditionType)
+	if !built.IsTrue() && built.Reason != api.BuildSkippedReason {
+		return false
+	}
+	if !workflow.IsKubernetesDeployment() || workflow.IsBlueGreenDeployment() || workflow.Status.ObservedGeneration != workflow.Generation {
+		return false
+	}
+	policy, err := r.GetPolicy(context.TODO(), workflow)
+	if err != nil {
+		klog.V(log.E).ErrorS(err, "Failed to get the workflow recovery policy", "Workflow", workflow.Name)
+		return false
+	}
+	return policy != nil
+}
+
+// recoveryPolicy returns the platform recovery policy, a nil policy disables the recovery.
+func recoveryPolicy(plf *operatorapi.SonataFlowPlatform) *operatorapi.RecoveryPolicySpec {
+	if plf == nil {
+		return nil
+	}
+	return plf.Spec.Recovery
+}
+
+// resetWorkflow marks the Deployment as unavailable, so the deployment states create the removed objects again.
+func resetWorkflow(workflow *operatorapi.SonataFlow) {
+	workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.DeploymentUnavailableReason, "Recovering the workflow from failure")
+}
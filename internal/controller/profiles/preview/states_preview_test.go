@@ -18,14 +18,22 @@
 package preview
 
 import (
+	"context"
 	"testing"
 
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api/metadata"
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+
 	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
 
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/common"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/test"
 	"github.com/serverlessworkflow/sdk-go/v2/model"
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientruntime "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func Test_deployWithBuildWorkflowState_isWorkflowChanged(t *testing.T) {
@@ -52,3 +60,51 @@ func Test_deployWithBuildWorkflowState_isWorkflowChanged(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, hasChanged)
 }
+
+func Test_RecoverFromFailureState_CanReconcile(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithPreviewProfile(t.Name())
+	workflow.Status.Manager().MarkTrue(api.BuiltConditionType)
+	workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.DeploymentFailureReason, "")
+	platform := test.GetBasePlatformInReadyPhase(workflow.Namespace)
+	client := test.NewSonataFlowClientBuilder().WithRuntimeObjects(workflow, platform).Build()
+	state := NewRecoverFromFailureState(&common.StateSupport{C: client})
+
+	// the recovery is disabled by default
+	assert.False(t, state.CanReconcile(workflow))
+
+	platform.Spec.Recovery = &operatorapi.RecoveryPolicySpec{}
+	assert.NoError(t, client.Update(context.TODO(), platform))
+	assert.True(t, state.CanReconcile(workflow))
+
+	// a spec change is reconciled by the deployment states
+	workflow.Generation = workflow.Status.ObservedGeneration + 1
+	assert.False(t, state.CanReconcile(workflow))
+	workflow.Generation = workflow.Status.ObservedGeneration
+
+	// an unavailable deployment is still followed by the deployment states
+	workflow.Status.Manager().MarkFalse(api.RunningConditionType, api.DeploymentUnavailableReason, "")
+	assert.False(t, state.CanReconcile(workflow))
+}
+
+func Test_followBuildStatusState_BlueGreenDoesNotRestartActiveDeployment(t *testing.T) {
+	workflow := test.GetBaseSonataFlowWithPreviewProfile(t.Name())
+	workflow.Spec.PodTemplate.BlueGreen = &operatorapi.BlueGreenRolloutSpec{}
+	workflow.Status.BlueGreen = &operatorapi.BlueGreenStatus{ActiveColor: operatorapi.BlueDeploymentColor}
+	workflow.Status.Manager().MarkFalse(api.BuiltConditionType, api.BuildIsRunningReason, "")
+	workflow.Status.Manager().MarkTrue(api.RunningConditionType)
+	build := &operatorapi.SonataFlowBuild{ObjectMeta: metav1.ObjectMeta{Name: workflow.Name, Namespace: workflow.Namespace}}
+	build.Status.BuildPhase = operatorapi.BuildPhaseSucceeded
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+		Name:      common.GetBlueGreenDeploymentName(workflow, operatorapi.BlueDeploymentColor),
+		Namespace: workflow.Namespace,
+	}}
+	client := test.NewSonataFlowClientBuilder().WithRuntimeObjects(workflow, build, deployment).WithStatusSubresource(workflow, build).Build()
+	state := &followBuildStatusState{StateSupport: &common.StateSupport{C: client, Recorder: test.NewFakeRecorder()}}
+
+	assert.True(t, workflow.Status.IsReady())
+	_, _, err := state.Do(context.TODO(), workflow)
+	assert.NoError(t, err)
+	assert.True(t, workflow.Status.GetCondition(api.BuiltConditionType).IsTrue())
+	assert.NoError(t, client.Get(context.TODO(), clientruntime.ObjectKeyFromObject(deployment), deployment))
+	assert.NotContains(t, deployment.Spec.Template.Annotations, metadata.RestartedAt)
+}
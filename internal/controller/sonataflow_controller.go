@@ -23,10 +23,15 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/certmanager"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/gateway"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/keda"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/knative"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/monitoring"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/workflowproj"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1"
 
 	"k8s.io/klog/v2"
 
@@ -35,12 +40,16 @@ import (
 	profiles "github.com/apache/incubator-kie-kogito-serverless-operator/internal/controller/profiles/factory"
 
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/client-go/rest"
 
 	prometheus "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
@@ -145,6 +154,9 @@ func (r *SonataFlowReconciler) cleanupTriggers(ctx context.Context, workflow *op
 			return err
 		}
 	}
+	if err := knative.DeleteEventTypes(ctx, r.Client, workflowproj.GetSelectorLabels(workflow)); err != nil {
+		return err
+	}
 	controllerutil.RemoveFinalizer(workflow, constants.TriggerFinalizer)
 	return r.Client.Update(ctx, workflow)
 }
@@ -157,7 +169,9 @@ func (r *SonataFlowReconciler) Delete(e event.DeleteEvent) error {
 func platformEnqueueRequestsFromMapFunc(c client.Client, p *operatorapi.SonataFlowPlatform) []reconcile.Request {
 	var requests []reconcile.Request
 
-	if p.Status.IsReady() {
+	// Dev workflows scaled down while idle are woken up once the platform no longer defines an idle timeout
+	wakeUpIdle := p.Spec.DevMode.IdleTimeout == nil
+	if p.Status.IsReady() || wakeUpIdle {
 		list := &operatorapi.SonataFlowList{}
 
 		// Do global search in case of global operator (it may be using a global platform)
@@ -173,15 +187,19 @@ func platformEnqueueRequestsFromMapFunc(c client.Client, p *operatorapi.SonataFl
 
 		for _, workflow := range list.Items {
 			cond := workflow.Status.GetTopLevelCondition()
-			if cond.IsFalse() && api.WaitingForPlatformReason == cond.Reason {
+			if p.Status.IsReady() && cond.IsFalse() && api.WaitingForPlatformReason == cond.Reason {
 				klog.V(log.I).InfoS("Platform ready, wake-up workflow", "platform", p.Name, "workflow", workflow.Name)
-				requests = append(requests, reconcile.Request{
-					NamespacedName: types.NamespacedName{
-						Namespace: workflow.Namespace,
-						Name:      workflow.Name,
-					},
-				})
+			} else if wakeUpIdle && workflow.Status.IsScaledDownWhileIdle() {
+				klog.V(log.I).InfoS("Platform has no idle timeout, wake-up workflow", "platform", p.Name, "workflow", workflow.Name)
+			} else {
+				continue
 			}
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: workflow.Namespace,
+					Name:      workflow.Name,
+				},
+			})
 		}
 	}
 	return requests
@@ -226,6 +244,11 @@ func (r *SonataFlowReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		For(&operatorapi.SonataFlow{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Service{}).
+		Owns(&networkingv1.Ingress{}).
+		Owns(&policyv1.PodDisruptionBudget{}).
+		Owns(&networkingv1.NetworkPolicy{}).
+		Owns(&batchv1.CronJob{}).
+		Owns(&batchv1.Job{}).
 		Owns(&corev1.ConfigMap{}).
 		Owns(&operatorapi.SonataFlowBuild{}).
 		Watches(&operatorapi.SonataFlowPlatform{}, handler.EnqueueRequestsFromMapFunc(func(c context.Context, a client.Object) []reconcile.Request {
@@ -264,6 +287,31 @@ func (r *SonataFlowReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if promAvail {
 		builder = builder.Owns(&prometheus.ServiceMonitor{})
 	}
+	kedaAvail, err := keda.GetKedaAvailability(mgr.GetConfig())
+	if err != nil {
+		return err
+	}
+	if kedaAvail {
+		scaledObject := &unstructured.Unstructured{}
+		scaledObject.SetGroupVersionKind(keda.ScaledObjectGVK)
+		builder = builder.Owns(scaledObject)
+	}
+	certManagerAvail, err := certmanager.GetCertManagerAvailability(mgr.GetConfig())
+	if err != nil {
+		return err
+	}
+	if certManagerAvail {
+		certificate := &unstructured.Unstructured{}
+		certificate.SetGroupVersionKind(certmanager.CertificateGVK)
+		builder = builder.Owns(certificate)
+	}
+	gatewayAvail, err := gateway.GetGatewayAPIAvailability(mgr.GetConfig())
+	if err != nil {
+		return err
+	}
+	if gatewayAvail {
+		builder = builder.Owns(&gwapi.HTTPRoute{})
+	}
 
 	return builder.Complete(r)
 }
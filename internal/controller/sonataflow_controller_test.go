@@ -22,10 +22,12 @@ package controller
 import (
 	"context"
 	"testing"
+	"time"
 
 	"k8s.io/client-go/rest"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -85,3 +87,23 @@ func TestSonataFlowController(t *testing.T) {
 		assert.Equal(t, ksp.Namespace, afterReconcileWorkflow.Status.Platform.Namespace)
 	})
 }
+
+func TestPlatformEnqueueRequestsWakeUpIdleWorkflows(t *testing.T) {
+	namespace := t.Name()
+	idle := test.GetBaseSonataFlowWithDevProfile(namespace)
+	idle.Status.Manager().MarkFalse(api.RunningConditionType, api.ScaledDownWhileIdleReason, "")
+	running := test.GetBaseSonataFlowWithDevProfile(namespace)
+	running.Name = "running"
+	running.Status.Manager().MarkTrue(api.RunningConditionType)
+	plat := test.GetBasePlatform()
+	plat.Namespace = namespace
+	plat.Spec.DevMode.IdleTimeout = &metav1.Duration{Duration: time.Minute}
+	cl := test.NewSonataFlowClientBuilder().WithRuntimeObjects(idle, running, plat).Build()
+
+	// the idle workflows are kept scaled down while the platform defines an idle timeout
+	assert.Empty(t, platformEnqueueRequestsFromMapFunc(cl, plat))
+
+	plat.Spec.DevMode.IdleTimeout = nil
+	requests := platformEnqueueRequestsFromMapFunc(cl, plat)
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: namespace, Name: idle.Name}}}, requests)
+}
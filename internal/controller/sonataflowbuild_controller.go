@@ -33,6 +33,7 @@ import (
 	imgv1 "github.com/openshift/api/image/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
@@ -57,6 +58,7 @@ type SonataFlowBuildReconciler struct {
 const (
 	requeueAfterForNewBuild     = 10 * time.Second
 	requeueAfterForBuildRunning = 30 * time.Second
+	requeueAfterForBuildQueued  = 15 * time.Second
 )
 
 // +kubebuilder:rbac:groups=sonataflow.org,resources=sonataflowbuilds,verbs=get;list;watch;create;update;patch;delete
@@ -89,17 +91,37 @@ func (r *SonataFlowBuildReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, err
 	}
 
-	if phase == operatorapi.BuildPhaseNone || kubeutil.GetAnnotationAsBool(build, operatorapi.BuildRestartAnnotation) {
+	if phase == operatorapi.BuildPhaseNone || phase == operatorapi.BuildPhaseQueued || kubeutil.GetAnnotationAsBool(build, operatorapi.BuildRestartAnnotation) {
+		admitted, err := builder.NewBuildQueue(ctx, r.Client).Admit(build)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !admitted {
+			return r.holdQueuedBuild(ctx, build, phase)
+		}
 		return r.scheduleNewBuild(ctx, buildManager, build)
-	} else if phase != operatorapi.BuildPhaseSucceeded && phase != operatorapi.BuildPhaseError && phase != operatorapi.BuildPhaseFailed {
+	} else if phase != operatorapi.BuildPhaseSucceeded && phase != operatorapi.BuildPhaseError &&
+		(phase != operatorapi.BuildPhaseFailed || build.Status.NextRetryAt != nil) {
 		beforeReconcileStatus := build.Status.DeepCopy()
 		if err = buildManager.Reconcile(build); err != nil {
 			return ctrl.Result{}, err
 		}
+		if build.Status.BuildPhase == operatorapi.BuildPhaseSucceeded {
+			completedAt := metav1.Now()
+			build.Status.CompletedAt = &completedAt
+		}
 		if !reflect.DeepEqual(build.Status, beforeReconcileStatus) {
 			if err = r.manageStatusUpdate(ctx, build, beforeReconcileStatus.BuildPhase); err != nil {
 				return ctrl.Result{}, err
 			}
+			if build.Status.RetryAttempts > beforeReconcileStatus.RetryAttempts {
+				r.Recorder.Event(build, corev1.EventTypeNormal, "Retrying", fmt.Sprintf("Retrying failed build, attempt %d", build.Status.RetryAttempts))
+			}
+		}
+		if build.Status.NextRetryAt != nil {
+			if untilRetry := time.Until(build.Status.NextRetryAt.Time); untilRetry > 0 && untilRetry < requeueAfterForBuildRunning {
+				return ctrl.Result{RequeueAfter: untilRetry}, nil
+			}
 		}
 		return ctrl.Result{RequeueAfter: requeueAfterForBuildRunning}, nil
 	}
@@ -108,6 +130,9 @@ func (r *SonataFlowBuildReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 }
 
 func (r *SonataFlowBuildReconciler) scheduleNewBuild(ctx context.Context, buildManager builder.BuildManager, build *operatorapi.SonataFlowBuild) (ctrl.Result, error) {
+	// a new build starts over the retry policy
+	build.Status.RetryAttempts = 0
+	build.Status.NextRetryAt = nil
 	if err := buildManager.Schedule(build); err != nil {
 		return ctrl.Result{}, err
 	}
@@ -133,6 +158,23 @@ func (r *SonataFlowBuildReconciler) scheduleNewBuild(ctx context.Context, buildM
 	return ctrl.Result{RequeueAfter: requeueAfterForNewBuild}, nil
 }
 
+// holdQueuedBuild persists the build queue position and signals to the workflow that the build is waiting for a free build slot.
+func (r *SonataFlowBuildReconciler) holdQueuedBuild(ctx context.Context, build *operatorapi.SonataFlowBuild, beforeReconcilePhase operatorapi.BuildPhase) (ctrl.Result, error) {
+	if err := r.manageStatusUpdate(ctx, build, beforeReconcilePhase); err != nil {
+		return ctrl.Result{}, err
+	}
+	if beforeReconcilePhase != operatorapi.BuildPhaseQueued {
+		workflowManager, err := workflows.NewManager(r.Client, ctx, build.Namespace, build.Name)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if err = workflowManager.SetBuiltStatusToRunning(fmt.Sprintf("Build queued at position %d", build.Status.QueuePosition)); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{RequeueAfter: requeueAfterForBuildQueued}, nil
+}
+
 func (r *SonataFlowBuildReconciler) manageStatusUpdate(ctx context.Context, instance *operatorapi.SonataFlowBuild, beforeReconcilePhase operatorapi.BuildPhase) error {
 	err := r.Status().Update(ctx, instance)
 	// Don't need to spam events if the phase hasn't changed
@@ -33,6 +33,7 @@ import (
 	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/container-builder/api"
 	"github.com/apache/incubator-kie-kogito-serverless-operator/test"
+	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
 )
 
 func TestSonataFlowBuildController(t *testing.T) {
@@ -138,3 +139,46 @@ func TestSonataFlowBuildController_MarkToRestart(t *testing.T) {
 	ksb = test.MustGetBuild(t, cl, types.NamespacedName{Name: ksb.Name, Namespace: namespace})
 	assert.Equal(t, "false", ksb.Annotations[operatorapi.BuildRestartAnnotation])
 }
+
+func TestSonataFlowBuildController_QueuedWhenPlatformLimitReached(t *testing.T) {
+	namespace := t.Name()
+	ksw := test.GetBaseSonataFlow(namespace)
+	ksb := test.GetNewEmptySonataFlowBuild(ksw.Name, namespace)
+	runningBuild := test.GetNewEmptySonataFlowBuild("running", namespace)
+	runningBuild.Status.BuildPhase = operatorapi.BuildPhaseRunning
+	ksp := test.GetBasePlatformInReadyPhase(namespace)
+	ksp.Spec.Build.Config.MaxConcurrentBuilds = utils.Pint(1)
+
+	cl := test.NewSonataFlowClientBuilder().
+		WithRuntimeObjects(ksb, ksw, runningBuild).
+		WithRuntimeObjects(ksp).
+		WithRuntimeObjects(test.GetSonataFlowBuilderConfig(namespace)).
+		WithStatusSubresource(ksb, ksw, runningBuild).
+		Build()
+
+	r := &SonataFlowBuildReconciler{cl, cl.Scheme(), &record.FakeRecorder{}, &rest.Config{}}
+	req := reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Name:      ksb.Name,
+			Namespace: ksb.Namespace,
+		},
+	}
+
+	result, err := r.Reconcile(context.TODO(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, requeueAfterForBuildQueued, result.RequeueAfter)
+	ksb = test.MustGetBuild(t, cl, req.NamespacedName)
+	assert.Equal(t, operatorapi.BuildPhaseQueued, ksb.Status.BuildPhase)
+	assert.Equal(t, int32(1), ksb.Status.QueuePosition)
+
+	// the running build finishes, so the queued one takes its slot
+	runningBuild.Status.BuildPhase = operatorapi.BuildPhaseSucceeded
+	assert.NoError(t, cl.Status().Update(context.TODO(), runningBuild))
+	result, err = r.Reconcile(context.TODO(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, requeueAfterForNewBuild, result.RequeueAfter)
+	ksb = test.MustGetBuild(t, cl, req.NamespacedName)
+	assert.Equal(t, operatorapi.BuildPhaseScheduling, ksb.Status.BuildPhase)
+	assert.Equal(t, int32(0), ksb.Status.QueuePosition)
+	assert.Nil(t, ksb.Status.QueuedAt)
+}
@@ -199,8 +199,8 @@ func (r *SonataFlowPlatformReconciler) Reconcile(ctx context.Context, req reconc
 
 }
 
-func (r *SonataFlowPlatformReconciler) cleanupTriggers(ctx context.Context, platform *operatorapi.SonataFlowPlatform) error {
-	for _, triggerRef := range platform.Status.Triggers {
+func (r *SonataFlowPlatformReconciler) cleanupTriggers(ctx context.Context, pl *operatorapi.SonataFlowPlatform) error {
+	for _, triggerRef := range pl.Status.Triggers {
 		trigger := &eventingv1.Trigger{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      triggerRef.Name,
@@ -211,8 +211,11 @@ func (r *SonataFlowPlatformReconciler) cleanupTriggers(ctx context.Context, plat
 			return err
 		}
 	}
-	controllerutil.RemoveFinalizer(platform, constants.TriggerFinalizer)
-	return r.Client.Update(ctx, platform)
+	if err := knative.DeleteEventTypes(ctx, r.Client, platform.GetServicesCommonLabels(pl)); err != nil {
+		return err
+	}
+	controllerutil.RemoveFinalizer(pl, constants.TriggerFinalizer)
+	return r.Client.Update(ctx, pl)
 }
 
 // sonataFlowPlatformUpdateStatus If an active cluster platform exists, update platform.Status accordingly
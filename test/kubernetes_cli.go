@@ -27,6 +27,7 @@ import (
 	"github.com/apache/incubator-kie-kogito-serverless-operator/utils"
 	prometheus "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	eventingv1beta2 "knative.dev/eventing/pkg/apis/eventing/v1beta2"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
@@ -43,6 +44,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1"
 
 	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
 )
@@ -78,6 +80,7 @@ func (s *SonataFlowClientBuilder) WithStatusSubresource(o ...ctrl.Object) *Sonat
 func NewSonataFlowClientBuilder() *SonataFlowClientBuilder {
 	s := scheme.Scheme
 	utilruntime.Must(operatorapi.AddToScheme(s))
+	utilruntime.Must(gwapi.Install(s))
 	builder := fake.NewClientBuilder().WithScheme(s)
 	return &SonataFlowClientBuilder{
 		innerBuilder: builder,
@@ -103,6 +106,7 @@ func NewKogitoClientBuilderWithOpenShift() *SonataFlowClientBuilder {
 	utilruntime.Must(imgv1.Install(s))
 	utilruntime.Must(operatorapi.AddToScheme(s))
 	utilruntime.Must(eventingv1.AddToScheme(s))
+	utilruntime.Must(eventingv1beta2.AddToScheme(s))
 	utilruntime.Must(sourcesv1.AddToScheme(s))
 	utilruntime.Must(prometheus.AddToScheme(s))
 	builder := fake.NewClientBuilder().WithScheme(s)
@@ -338,6 +338,28 @@ func CreateFakeKnativeAndMonitoringDiscoveryClient() discovery.DiscoveryInterfac
 	}
 }
 
+// CreateFakeGatewayAPIDiscoveryClient creates a fake discovery client exposing the Gateway API group.
+func CreateFakeGatewayAPIDiscoveryClient() discovery.DiscoveryInterface {
+	return &discfake.FakeDiscovery{
+		Fake: &clienttesting.Fake{
+			Resources: []*metav1.APIResourceList{
+				{GroupVersion: "gateway.networking.k8s.io/v1"},
+			},
+		},
+	}
+}
+
+// CreateFakeCertManagerDiscoveryClient creates a fake discovery client exposing the cert-manager group.
+func CreateFakeCertManagerDiscoveryClient() discovery.DiscoveryInterface {
+	return &discfake.FakeDiscovery{
+		Fake: &clienttesting.Fake{
+			Resources: []*metav1.APIResourceList{
+				{GroupVersion: "cert-manager.io/v1"},
+			},
+		},
+	}
+}
+
 func GetDefaultBroker(namespace string) *eventingv1.Broker {
 	broker := &eventingv1.Broker{}
 	GetKubernetesResource(knativeDefaultBrokerCR, broker)
@@ -73,6 +73,11 @@ func Pint(i int32) *int32 {
 	return &i
 }
 
+// Pint64 returns a pointer to an int64
+func Pint64(i int64) *int64 {
+	return &i
+}
+
 func Compare(a, b []byte) bool {
 	a = append(a, b...)
 	c := 0
@@ -20,10 +20,12 @@
 package kubernetes
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/apache/incubator-kie-kogito-serverless-operator/api/metadata"
@@ -32,13 +34,17 @@ import (
 	"github.com/apache/incubator-kie-kogito-serverless-operator/workflowproj"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
 	// this const is available here https://github.com/kubernetes/kubernetes/blob/6e0cb243d57592c917fe449dde20b0e246bc66be/pkg/controller/deployment/util/deployment_util.go#L100
 	// but it doesn't worth the dependency.
 	deploymentMinimumReplicasUnavailable = "MinimumReplicasUnavailable"
+	// startupSecondsAnnotation holds the time, in seconds, the workflow container took to become ready the first time in a pod
+	startupSecondsAnnotation = metadata.Domain + "/startupSeconds"
 )
 
 // IsDeploymentAvailable verifies if the Deployment conditions match the Available status
@@ -164,6 +170,75 @@ func calculateHash(userPropsCM, managedPropsCM *v1.ConfigMap, workflow *operator
 	return hashString, nil
 }
 
+// GetDeploymentObservedStartupSeconds returns the longest time, in seconds, the given container took to become ready
+// in the current pods of the given deployment. Zero is returned if no pod is ready yet.
+// The startup time of a pod is measured the first time it's seen ready and kept in its startupSecondsAnnotation, so that
+// later readiness transitions don't change it.
+func GetDeploymentObservedStartupSeconds(ctx context.Context, c client.Client, deployment *appsv1.Deployment, container string) (int32, error) {
+	podList := &v1.PodList{}
+	opts := &client.ListOptions{
+		LabelSelector: labels.SelectorFromSet(deployment.Spec.Selector.MatchLabels),
+		Namespace:     deployment.Namespace,
+	}
+	if err := c.List(ctx, podList, opts); err != nil {
+		return 0, err
+	}
+	var observed int32
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		startup, err := getPodStartupSeconds(ctx, c, pod, container)
+		if err != nil {
+			return 0, err
+		}
+		if startup > observed {
+			observed = startup
+		}
+	}
+	return observed, nil
+}
+
+// getPodStartupSeconds gets the time, in seconds, the given container took to become ready the first time in the given pod.
+// Zero is returned if the container isn't ready yet.
+func getPodStartupSeconds(ctx context.Context, c client.Client, pod *v1.Pod, container string) (int32, error) {
+	if value, ok := pod.Annotations[startupSecondsAnnotation]; ok {
+		if startup, err := strconv.ParseInt(value, 10, 32); err == nil {
+			return int32(startup), nil
+		}
+	}
+	readyTime := getPodReadyTime(pod)
+	if readyTime == nil {
+		return 0, nil
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name != container || status.State.Running == nil || !status.Ready {
+			continue
+		}
+		startup := int32(readyTime.Sub(status.State.Running.StartedAt.Time).Seconds())
+		patch := client.MergeFrom(pod.DeepCopy())
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+		pod.Annotations[startupSecondsAnnotation] = strconv.FormatInt(int64(startup), 10)
+		if err := c.Patch(ctx, pod, patch); err != nil {
+			return 0, err
+		}
+		return startup, nil
+	}
+	return 0, nil
+}
+
+func getPodReadyTime(pod *v1.Pod) *time.Time {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady && condition.Status == v1.ConditionTrue {
+			return &condition.LastTransitionTime.Time
+		}
+	}
+	return nil
+}
+
 // GetContainerByName returns a pointer to the Container within the given Deployment.
 // If none found, returns nil.
 // It also returns the position where the container was found, -1 if none
@@ -0,0 +1,77 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newReadyPod(name string, startedAt, readyAt time.Time) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"app": "workflow"}},
+		Status: v1.PodStatus{
+			Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue, LastTransitionTime: metav1.NewTime(readyAt)}},
+			ContainerStatuses: []v1.ContainerStatus{{
+				Name:  "workflow",
+				Ready: true,
+				State: v1.ContainerState{Running: &v1.ContainerStateRunning{StartedAt: metav1.NewTime(startedAt)}},
+			}},
+		},
+	}
+}
+
+func TestGetDeploymentObservedStartupSeconds(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "workflow", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "workflow"}}},
+	}
+	started := time.Now().Add(-time.Hour)
+	pod := newReadyPod("workflow-1", started, started.Add(30*time.Second))
+	c := fake.NewClientBuilder().WithObjects(pod).WithStatusSubresource(pod).Build()
+
+	observed, err := GetDeploymentObservedStartupSeconds(context.TODO(), c, deployment, "workflow")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(30), observed)
+
+	// a later readiness flap doesn't change the first measure
+	assert.NoError(t, c.Get(context.TODO(), client.ObjectKeyFromObject(pod), pod))
+	assert.Equal(t, "30", pod.Annotations[startupSecondsAnnotation])
+	pod.Status.Conditions[0].LastTransitionTime = metav1.NewTime(started.Add(50 * time.Minute))
+	assert.NoError(t, c.Status().Update(context.TODO(), pod))
+	observed, err = GetDeploymentObservedStartupSeconds(context.TODO(), c, deployment, "workflow")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(30), observed)
+
+	// the pods of a new template are measured again
+	assert.NoError(t, c.Delete(context.TODO(), pod))
+	assert.NoError(t, c.Create(context.TODO(), newReadyPod("workflow-2", started, started.Add(10*time.Second))))
+	observed, err = GetDeploymentObservedStartupSeconds(context.TODO(), c, deployment, "workflow")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(10), observed)
+}
@@ -0,0 +1,112 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"sort"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	"github.com/apache/incubator-kie-kogito-serverless-operator/api/metadata"
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+)
+
+// IngressForService creates a networking.k8s.io/v1 Ingress routing the given exposure to the service port.
+func IngressForService(meta metav1.ObjectMeta, exposure *operatorapi.ExposureSpec, serviceName string, servicePort int32) *networkingv1.Ingress {
+	ingress := &networkingv1.Ingress{ObjectMeta: meta}
+	SetIngressSpec(ingress, exposure, serviceName, servicePort)
+	return ingress
+}
+
+// SetIngressSpec overrides the Ingress spec with the one defined by the given exposure and merges its annotations.
+// The annotations set by others, for example, by the ingress controller, are kept.
+func SetIngressSpec(ingress *networkingv1.Ingress, exposure *operatorapi.ExposureSpec, serviceName string, servicePort int32) {
+	pathType := networkingv1.PathTypePrefix
+	setExposureAnnotations(ingress, exposure.Annotations)
+	ingress.Spec = networkingv1.IngressSpec{
+		IngressClassName: exposure.IngressClassName,
+		Rules: []networkingv1.IngressRule{{
+			Host: exposure.Host,
+			IngressRuleValue: networkingv1.IngressRuleValue{
+				HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{{
+						Path:     exposure.GetPath(),
+						PathType: &pathType,
+						Backend: networkingv1.IngressBackend{
+							Service: &networkingv1.IngressServiceBackend{
+								Name: serviceName,
+								Port: networkingv1.ServiceBackendPort{Number: servicePort},
+							},
+						},
+					}},
+				},
+			},
+		}},
+	}
+	if len(exposure.TLSSecretName) > 0 {
+		ingress.Spec.TLS = []networkingv1.IngressTLS{{
+			Hosts:      []string{exposure.Host},
+			SecretName: exposure.TLSSecretName,
+		}}
+	}
+}
+
+// setExposureAnnotations merges the given exposure annotations in the Ingress ones, removing the ones previously set
+// from the exposure that it no longer defines.
+func setExposureAnnotations(ingress *networkingv1.Ingress, annotations map[string]string) {
+	merged := make(map[string]string, len(ingress.Annotations)+len(annotations)+1)
+	for key, value := range ingress.Annotations {
+		merged[key] = value
+	}
+	if previous := merged[metadata.ExposureAnnotations]; len(previous) > 0 {
+		for _, key := range strings.Split(previous, ",") {
+			delete(merged, key)
+		}
+	}
+	delete(merged, metadata.ExposureAnnotations)
+	keys := make([]string, 0, len(annotations))
+	for key, value := range annotations {
+		merged[key] = value
+		keys = append(keys, key)
+	}
+	if len(keys) > 0 {
+		sort.Strings(keys)
+		merged[metadata.ExposureAnnotations] = strings.Join(keys, ",")
+	}
+	if len(merged) == 0 {
+		merged = nil
+	}
+	ingress.Annotations = merged
+}
+
+// ExposureURL gets the external URL of the given exposure.
+func ExposureURL(exposure *operatorapi.ExposureSpec) *apis.URL {
+	var url *apis.URL
+	if len(exposure.TLSSecretName) > 0 {
+		url = apis.HTTPS(exposure.Host)
+	} else {
+		url = apis.HTTP(exposure.Host)
+	}
+	url.Path = exposure.GetPath()
+	return url
+}
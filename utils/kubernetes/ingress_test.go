@@ -0,0 +1,52 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorapi "github.com/apache/incubator-kie-kogito-serverless-operator/api/v1alpha08"
+)
+
+func TestSetIngressSpecAnnotations(t *testing.T) {
+	exposure := &operatorapi.ExposureSpec{
+		Host:        "greeting.example.com",
+		Annotations: map[string]string{"nginx.ingress.kubernetes.io/proxy-body-size": "8m"},
+	}
+	ingress := IngressForService(metav1.ObjectMeta{Name: "greeting", Namespace: "default"}, exposure, "greeting", 80)
+	assert.Equal(t, "8m", ingress.Annotations["nginx.ingress.kubernetes.io/proxy-body-size"])
+
+	// the annotations set by others are kept, the exposure ones are not shared with the exposure
+	ingress.Annotations["ingress.kubernetes.io/status"] = "ready"
+	assert.NotContains(t, exposure.Annotations, "ingress.kubernetes.io/status")
+
+	// the annotations removed from the exposure are removed from the Ingress
+	exposure.Annotations = map[string]string{"nginx.ingress.kubernetes.io/ssl-redirect": "true"}
+	SetIngressSpec(ingress, exposure, "greeting", 80)
+	assert.NotContains(t, ingress.Annotations, "nginx.ingress.kubernetes.io/proxy-body-size")
+	assert.Equal(t, "true", ingress.Annotations["nginx.ingress.kubernetes.io/ssl-redirect"])
+	assert.Equal(t, "ready", ingress.Annotations["ingress.kubernetes.io/status"])
+
+	exposure.Annotations = nil
+	SetIngressSpec(ingress, exposure, "greeting", 80)
+	assert.Equal(t, map[string]string{"ingress.kubernetes.io/status": "ready"}, ingress.Annotations)
+}
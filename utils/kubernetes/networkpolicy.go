@@ -0,0 +1,254 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/apache/incubator-kie-kogito-serverless-operator/workflowproj"
+)
+
+const (
+	// namespaceNameLabel is the immutable label set by Kubernetes on every namespace with its name.
+	namespaceNameLabel = "kubernetes.io/metadata.name"
+	dnsPort            = 53
+)
+
+var clusterDomainSuffixes = []string{".svc", ".svc.cluster.local", ".pod.cluster.local"}
+
+// NetworkPolicyTraffic describes the traffic allowed to and from the pods of a workflow or of a platform service.
+// The traffic between the pods managed by the operator, and the name resolution, are always allowed.
+type NetworkPolicyTraffic struct {
+	// PodSelector selects the pods the policy applies to.
+	PodSelector map[string]string
+	// HTTPPort is the port serving the HTTP traffic of the pods.
+	HTTPPort intstr.IntOrString
+	// HTTPSPort is the port serving the HTTPS traffic of the pods, if any.
+	HTTPSPort *intstr.IntOrString
+	// HTTPFromAnywhere allows any source to reach the HTTP port, for example, when the pods are exposed outside the cluster.
+	HTTPFromAnywhere bool
+	// HTTPFromNamespaces allows the pods in these namespaces to reach the HTTP port.
+	HTTPFromNamespaces []string
+	// FromAnywhere allows any incoming traffic, for example, for the pods served by Knative Serving.
+	FromAnywhere bool
+	// FromNamespaces allows the pods in these namespaces to reach any port.
+	FromNamespaces []string
+	// ToNamespaces allows the pods to call any pod in these namespaces.
+	ToNamespaces []string
+	// ToCIDRs allows the pods to call these networks.
+	ToCIDRs []string
+	// ToServers allows the pods to call these "host:port" addresses, for example, the Kafka brokers.
+	// NetworkPolicies can't select DNS names, so only the port is enforced for the hosts outside the cluster.
+	ToServers []string
+}
+
+func (t *NetworkPolicyTraffic) httpPorts() []intstr.IntOrString {
+	if t.HTTPSPort == nil {
+		return []intstr.IntOrString{t.HTTPPort}
+	}
+	return []intstr.IntOrString{t.HTTPPort, *t.HTTPSPort}
+}
+
+// NetworkPolicyForTraffic creates a networking.k8s.io/v1 NetworkPolicy allowing only the given traffic.
+func NetworkPolicyForTraffic(meta metav1.ObjectMeta, traffic *NetworkPolicyTraffic) *networkingv1.NetworkPolicy {
+	policy := &networkingv1.NetworkPolicy{ObjectMeta: meta}
+	SetNetworkPolicySpec(policy, traffic)
+	return policy
+}
+
+// SetNetworkPolicySpec overrides the NetworkPolicy spec with the ingress and egress rules allowing only the given traffic.
+func SetNetworkPolicySpec(policy *networkingv1.NetworkPolicy, traffic *NetworkPolicyTraffic) {
+	operatorPods := NetworkPolicyPodsPeer(operatorManagedPodsSelector())
+	ingress := []networkingv1.NetworkPolicyIngressRule{{From: []networkingv1.NetworkPolicyPeer{operatorPods}}}
+	if traffic.FromAnywhere {
+		ingress = []networkingv1.NetworkPolicyIngressRule{{}}
+	} else {
+		if namespaces := SortedUniqueNamespaces(traffic.FromNamespaces); len(namespaces) > 0 {
+			ingress[0].From = append(ingress[0].From, NetworkPolicyNamespacesPeer(namespaces...))
+		}
+		if traffic.HTTPFromAnywhere && len(traffic.HTTPFromNamespaces) == 0 {
+			ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{Ports: NetworkPolicyTCPPorts(traffic.httpPorts()...)})
+		} else if namespaces := SortedUniqueNamespaces(traffic.HTTPFromNamespaces); len(namespaces) > 0 {
+			ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{
+				Ports: NetworkPolicyTCPPorts(traffic.httpPorts()...),
+				From:  []networkingv1.NetworkPolicyPeer{NetworkPolicyNamespacesPeer(namespaces...)},
+			})
+		}
+	}
+
+	egress := []networkingv1.NetworkPolicyEgressRule{DNSEgressRule(), {To: []networkingv1.NetworkPolicyPeer{operatorPods}}}
+	if namespaces := SortedUniqueNamespaces(traffic.ToNamespaces); len(namespaces) > 0 {
+		egress[1].To = append(egress[1].To, NetworkPolicyNamespacesPeer(namespaces...))
+	}
+	egress[1].To = append(egress[1].To, NetworkPolicyCIDRPeers(traffic.ToCIDRs)...)
+	for _, server := range traffic.ToServers {
+		if rule, ok := ServerEgressRule(server); ok {
+			egress = append(egress, rule)
+		}
+	}
+
+	policy.Spec = networkingv1.NetworkPolicySpec{
+		PodSelector: metav1.LabelSelector{MatchLabels: traffic.PodSelector},
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+		Ingress:     ingress,
+		Egress:      egress,
+	}
+}
+
+// operatorManagedPodsSelector selects the pods of the workflows and of the platform services managed by the operator.
+func operatorManagedPodsSelector() *metav1.LabelSelector {
+	return &metav1.LabelSelector{MatchLabels: map[string]string{workflowproj.LabelK8SManagedBy: "sonataflow-operator"}}
+}
+
+// NetworkPolicyNamespacesPeer selects all the pods in the given namespaces.
+func NetworkPolicyNamespacesPeer(namespaces ...string) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key:      namespaceNameLabel,
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   SortedUniqueNamespaces(namespaces),
+			}},
+		},
+	}
+}
+
+// NetworkPolicyPodsPeer selects the pods matching the given label selector. If namespaces is empty, the pods are selected
+// in every namespace.
+func NetworkPolicyPodsPeer(podSelector *metav1.LabelSelector, namespaces ...string) networkingv1.NetworkPolicyPeer {
+	if len(namespaces) == 0 {
+		return networkingv1.NetworkPolicyPeer{PodSelector: podSelector, NamespaceSelector: &metav1.LabelSelector{}}
+	}
+	peer := NetworkPolicyNamespacesPeer(namespaces...)
+	peer.PodSelector = podSelector
+	return peer
+}
+
+// NetworkPolicyCIDRPeers selects the given networks, usually outside the cluster.
+func NetworkPolicyCIDRPeers(cidrs []string) []networkingv1.NetworkPolicyPeer {
+	peers := make([]networkingv1.NetworkPolicyPeer, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		peers = append(peers, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: cidr}})
+	}
+	return peers
+}
+
+// NetworkPolicyTCPPorts returns the given ports as TCP NetworkPolicy ports.
+func NetworkPolicyTCPPorts(ports ...intstr.IntOrString) []networkingv1.NetworkPolicyPort {
+	protocol := corev1.ProtocolTCP
+	policyPorts := make([]networkingv1.NetworkPolicyPort, 0, len(ports))
+	for i := range ports {
+		policyPorts = append(policyPorts, networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &ports[i]})
+	}
+	return policyPorts
+}
+
+// DNSEgressRule allows the name resolution against the cluster DNS, wherever it runs.
+func DNSEgressRule() networkingv1.NetworkPolicyEgressRule {
+	udp := corev1.ProtocolUDP
+	tcp := corev1.ProtocolTCP
+	port := intstr.FromInt32(dnsPort)
+	return networkingv1.NetworkPolicyEgressRule{
+		To: []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{}}},
+		Ports: []networkingv1.NetworkPolicyPort{
+			{Protocol: &udp, Port: &port},
+			{Protocol: &tcp, Port: &port},
+		},
+	}
+}
+
+// ServerEgressRule allows the calls to the given "host:port" address: to the pods of its namespace for an in-cluster host,
+// to the single IP for an IP address, and to any destination on that port otherwise.
+// The returned bool is false if the address can't be parsed.
+func ServerEgressRule(server string) (networkingv1.NetworkPolicyEgressRule, bool) {
+	host, port, err := net.SplitHostPort(strings.TrimSpace(server))
+	if err != nil {
+		return networkingv1.NetworkPolicyEgressRule{}, false
+	}
+	portNumber, err := strconv.ParseInt(port, 10, 32)
+	if err != nil {
+		return networkingv1.NetworkPolicyEgressRule{}, false
+	}
+	rule := networkingv1.NetworkPolicyEgressRule{Ports: NetworkPolicyTCPPorts(intstr.FromInt32(int32(portNumber)))}
+	if ip := net.ParseIP(host); ip != nil {
+		bits := 32
+		if ip.To4() == nil {
+			bits = 128
+		}
+		rule.To = []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: (&net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}).String()}}}
+	} else if namespace, ok := ClusterNamespaceFromHost(host); ok {
+		rule.To = []networkingv1.NetworkPolicyPeer{NetworkPolicyNamespacesPeer(namespace)}
+	}
+	return rule, true
+}
+
+// ClusterNamespaceFromURL returns the namespace of the in-cluster address of the given URL, for example "my-namespace" for
+// http://my-service.my-namespace.svc:8080. The returned bool is false if the URL doesn't point to a cluster address.
+func ClusterNamespaceFromURL(rawURL string) (string, bool) {
+	// JDBC URLs are not parsable as is
+	parsed, err := url.Parse(strings.TrimPrefix(rawURL, "jdbc:"))
+	if err != nil || len(parsed.Host) == 0 {
+		return "", false
+	}
+	return ClusterNamespaceFromHost(parsed.Hostname())
+}
+
+// ClusterNamespaceFromHost returns the namespace of the given in-cluster host, for example "my-namespace" for
+// my-service.my-namespace.svc.cluster.local. The returned bool is false if the host isn't a cluster address.
+func ClusterNamespaceFromHost(host string) (string, bool) {
+	if net.ParseIP(host) != nil {
+		return "", false
+	}
+	for _, suffix := range clusterDomainSuffixes {
+		if name, found := strings.CutSuffix(host, suffix); found {
+			labels := strings.Split(name, ".")
+			if len(labels) == 2 && len(labels[0]) > 0 && len(labels[1]) > 0 {
+				return labels[1], true
+			}
+		}
+	}
+	return "", false
+}
+
+// SortedUniqueNamespaces returns the given non-empty namespaces without duplicates and sorted, so that the generated
+// NetworkPolicies are stable across reconciliations.
+func SortedUniqueNamespaces(namespaces []string) []string {
+	unique := make(map[string]struct{}, len(namespaces))
+	for _, namespace := range namespaces {
+		if len(namespace) > 0 {
+			unique[namespace] = struct{}{}
+		}
+	}
+	sorted := make([]string, 0, len(unique))
+	for namespace := range unique {
+		sorted = append(sorted, namespace)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,109 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one
+ * or more contributor license agreements.  See the NOTICE file
+ * distributed with this work for additional information
+ * regarding copyright ownership.  The ASF licenses this file
+ * to you under the Apache License, Version 2.0 (the
+ * "License"); you may not use this file except in compliance
+ * with the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestClusterNamespaceFromURL(t *testing.T) {
+	tests := []struct {
+		url       string
+		namespace string
+		inCluster bool
+	}{
+		{"http://my-service.my-namespace.svc:8080", "my-namespace", true},
+		{"http://my-service.my-namespace.svc.cluster.local/path", "my-namespace", true},
+		{"http://10-244-1-135.my-namespace.pod.cluster.local:8080", "my-namespace", true},
+		{"jdbc:postgresql://postgres.db.svc.cluster.local:5432/sonataflow", "db", true},
+		{"https://my-service.example.com", "", false},
+		{"http://10.245.1.132:8080", "", false},
+		{"http://my-service.svc", "", false},
+		{"not an url", "", false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			namespace, inCluster := ClusterNamespaceFromURL(tc.url)
+			assert.Equal(t, tc.inCluster, inCluster)
+			assert.Equal(t, tc.namespace, namespace)
+		})
+	}
+}
+
+func TestSetNetworkPolicySpec(t *testing.T) {
+	traffic := &NetworkPolicyTraffic{
+		PodSelector:        map[string]string{"app": "workflow"},
+		HTTPPort:           intstr.FromInt32(8080),
+		HTTPFromNamespaces: []string{"ingress-nginx"},
+		FromNamespaces:     []string{"knative-eventing"},
+		ToNamespaces:       []string{"services", "", "db", "services"},
+		ToCIDRs:            []string{"10.0.0.0/8"},
+	}
+	policy := NetworkPolicyForTraffic(metav1.ObjectMeta{Name: "workflow", Namespace: "default"}, traffic)
+
+	assert.Equal(t, traffic.PodSelector, policy.Spec.PodSelector.MatchLabels)
+	assert.ElementsMatch(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}, policy.Spec.PolicyTypes)
+	assert.Len(t, policy.Spec.Ingress, 2)
+	assert.Len(t, policy.Spec.Ingress[0].From, 2)
+	assert.Equal(t, []string{"knative-eventing"}, policy.Spec.Ingress[0].From[1].NamespaceSelector.MatchExpressions[0].Values)
+	assert.Equal(t, traffic.HTTPPort, *policy.Spec.Ingress[1].Ports[0].Port)
+	assert.Equal(t, []string{"ingress-nginx"}, policy.Spec.Ingress[1].From[0].NamespaceSelector.MatchExpressions[0].Values)
+
+	assert.Len(t, policy.Spec.Egress, 2)
+	assert.Equal(t, DNSEgressRule(), policy.Spec.Egress[0])
+	assert.Len(t, policy.Spec.Egress[1].To, 3)
+	assert.Equal(t, []string{"db", "services"}, policy.Spec.Egress[1].To[1].NamespaceSelector.MatchExpressions[0].Values)
+	assert.Equal(t, "10.0.0.0/8", policy.Spec.Egress[1].To[2].IPBlock.CIDR)
+
+	traffic.HTTPFromNamespaces = nil
+	traffic.HTTPFromAnywhere = true
+	SetNetworkPolicySpec(policy, traffic)
+	assert.Len(t, policy.Spec.Ingress, 2)
+	assert.Empty(t, policy.Spec.Ingress[1].From)
+
+	traffic.FromAnywhere = true
+	SetNetworkPolicySpec(policy, traffic)
+	assert.Equal(t, []networkingv1.NetworkPolicyIngressRule{{}}, policy.Spec.Ingress)
+}
+
+func TestServerEgressRule(t *testing.T) {
+	rule, ok := ServerEgressRule("my-cluster-kafka-bootstrap.kafka.svc:9092")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"kafka"}, rule.To[0].NamespaceSelector.MatchExpressions[0].Values)
+	assert.Equal(t, intstr.FromInt32(9092), *rule.Ports[0].Port)
+
+	rule, ok = ServerEgressRule("10.0.0.1:9093")
+	assert.True(t, ok)
+	assert.Equal(t, "10.0.0.1/32", rule.To[0].IPBlock.CIDR)
+
+	// DNS names outside the cluster can't be selected, only the port is enforced
+	rule, ok = ServerEgressRule("kafka.example.com:9094")
+	assert.True(t, ok)
+	assert.Empty(t, rule.To)
+	assert.Equal(t, intstr.FromInt32(9094), *rule.Ports[0].Port)
+
+	_, ok = ServerEgressRule("kafka.example.com")
+	assert.False(t, ok)
+}
@@ -20,7 +20,12 @@
 package kubernetes
 
 import (
+	"context"
+
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -40,3 +45,19 @@ func ToTypedLocalReference(object ctrl.Object) *v1.TypedLocalObjectReference {
 	}
 
 }
+
+// DeleteIfExists deletes the given object, identified by its name and namespace, if it exists in the cluster.
+// A kind not installed in the cluster, for example, when an optional operator is missing, is considered as not existing.
+func DeleteIfExists(ctx context.Context, c ctrl.Client, object ctrl.Object) error {
+	if err := c.Get(ctx, ctrl.ObjectKeyFromObject(object), object); err != nil {
+		return ignoreMissing(err)
+	}
+	return ignoreMissing(c.Delete(ctx, object))
+}
+
+func ignoreMissing(err error) error {
+	if errors.IsNotFound(err) || meta.IsNoMatchError(err) || runtime.IsNotRegisteredError(err) {
+		return nil
+	}
+	return err
+}
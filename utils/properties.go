@@ -20,9 +20,18 @@
 package utils
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/magiconair/properties"
 )
 
+const (
+	IncomingChannel = "incoming"
+	OutgoingChannel = "outgoing"
+)
+
 type ApplicationPropertiesBuilder interface {
 	WithInitialProperties(initialProperties *properties.Properties) ApplicationPropertiesBuilder
 	WithImmutableProperties(immutableProperties *properties.Properties) ApplicationPropertiesBuilder
@@ -87,3 +96,12 @@ func (a *applicationPropertiesBuilder) Build() *properties.Properties {
 func NewApplicationPropertiesBuilder() ApplicationPropertiesBuilder {
 	return &applicationPropertiesBuilder{}
 }
+
+// MessagingChannelProperty returns the SmallRye Reactive Messaging property configuring the given attribute of an incoming
+// or outgoing channel. Channels containing dots, like most of the CloudEvent types, are quoted.
+func MessagingChannelProperty(direction, channel, attribute string) string {
+	if strings.Contains(channel, ".") {
+		channel = strconv.Quote(channel)
+	}
+	return fmt.Sprintf("mp.messaging.%s.%s.%s", direction, channel, attribute)
+}
@@ -47,6 +47,8 @@ const (
 	LabelK8SComponent = "app.kubernetes.io/component"
 	LabelK8SPartOF    = "app.kubernetes.io/part-of"
 	LabelK8SManagedBy = "app.kubernetes.io/managed-by"
+	// LabelDeploymentColor identifies the blue/green Deployment the workflow pods belong to
+	LabelDeploymentColor = metadata.Domain + "/color"
 	// LabelWorkflowNamespace specialized label managed by the controller indicating the namespace of the workflow
 	LabelWorkflowNamespace = metadata.Domain + "/workflow-namespace"
 )